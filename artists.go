@@ -0,0 +1,126 @@
+package id3
+
+import (
+	"strings"
+)
+
+// ArtistSplitter is policy of splitting a value into multiple artists, matching is case-insensitive.
+// Null character, which separates multiple values of ID3v2.4, always separates artists.
+type ArtistSplitter struct {
+	// Separators separate artists, like "/" or "; ".
+	Separators []string
+	// Exceptions are artists which are not split, like "AC/DC".
+	Exceptions []string
+	// Featuring are markers of featured artists, like " feat. ", see SplitFeatured.
+	Featuring []string
+}
+
+// DefaultArtistSplitter splits on "/" and "; ", except for well-known artists with slash, and extracts featured artists.
+var DefaultArtistSplitter = ArtistSplitter{
+	Separators: []string{"/", "; "},
+	Exceptions: []string{"AC/DC", "Au/Ra", "Brothers/Sisters", "DJ/rupture", "Lou/Lou", "Stereo/Video"},
+	Featuring:  []string{" feat. ", " ft. ", " featuring "},
+}
+
+func (t ID3) artistSplitter() ArtistSplitter {
+	if t.ArtistSplitter != nil {
+		return *t.ArtistSplitter
+	}
+
+	return DefaultArtistSplitter
+}
+
+// Split will split value into artists, main artists are followed by featured artists and empty artists are dropped.
+func (s ArtistSplitter) Split(value string) []string {
+	artists, featured := s.SplitFeatured(value)
+
+	return append(artists, featured...)
+}
+
+// SplitFeatured will split value into main artists and featured artists, like "A" and "B" of "A feat. B".
+// Artists after a featuring marker are featured up to the end of the value, which ends at null character.
+// Exceptions are only matched as whole artists, so "Colou/Lou" is split even though "Lou/Lou" is an exception.
+func (s ArtistSplitter) SplitFeatured(value string) ([]string, []string) {
+	separators := append([]string{"\x00"}, s.Separators...)
+	boundaries := append(append([]string{}, separators...), s.Featuring...)
+	artists, featured := make([]string, 0), make([]string, 0)
+	start, isFeatured := 0, false
+
+	add := func(artist string) {
+		if artist = strings.TrimSpace(artist); artist == "" {
+			return
+		}
+
+		if isFeatured {
+			featured = append(featured, artist)
+		} else {
+			artists = append(artists, artist)
+		}
+	}
+
+	for i := 0; i < len(value); {
+		if strings.TrimSpace(value[start:i]) == "" {
+			exception := s.prefix(value[i:], s.Exceptions)
+			if exception != "" && s.isBoundary(value[i+len(exception):], boundaries) {
+				i += len(exception)
+
+				continue
+			}
+		}
+
+		if marker := s.prefix(value[i:], s.Featuring); marker != "" {
+			add(value[start:i])
+
+			i += len(marker)
+			start, isFeatured = i, true
+
+			continue
+		}
+
+		separator := s.prefix(value[i:], separators)
+		if separator == "" {
+			i++
+
+			continue
+		}
+
+		add(value[start:i])
+
+		if separator == "\x00" {
+			isFeatured = false
+		}
+
+		i += len(separator)
+		start = i
+	}
+
+	add(value[start:])
+
+	return artists, featured
+}
+
+// isBoundary reports whether rest of value, after an artist, is empty or starts with one of boundaries.
+func (s ArtistSplitter) isBoundary(rest string, boundaries []string) bool {
+	if strings.TrimSpace(rest) == "" {
+		return true
+	}
+
+	return s.prefix(rest, boundaries) != "" || s.prefix(strings.TrimLeft(rest, " "), boundaries) != ""
+}
+
+// prefix will return the longest of prefixes which value starts with.
+func (s ArtistSplitter) prefix(value string, prefixes []string) string {
+	res := ""
+
+	for _, prefix := range prefixes {
+		if prefix == "" || len(prefix) > len(value) || len(prefix) <= len(res) {
+			continue
+		}
+
+		if strings.EqualFold(value[:len(prefix)], prefix) {
+			res = prefix
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,37 @@
+package id3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArtistSplitterSplitFeatured(t *testing.T) {
+	tests := []struct {
+		value    string
+		artists  []string
+		featured []string
+	}{
+		{"", []string{}, []string{}},
+		{"Queen", []string{"Queen"}, []string{}},
+		{"AC/DC", []string{"AC/DC"}, []string{}},
+		{"ac/dc; Queen", []string{"ac/dc", "Queen"}, []string{}},
+		{"Queen / AC/DC", []string{"Queen", "AC/DC"}, []string{}},
+		{"Colou/Lou", []string{"Colou", "Lou"}, []string{}},
+		{"AC/DCX", []string{"AC", "DCX"}, []string{}},
+		{"A\x00B", []string{"A", "B"}, []string{}},
+		{"A feat. B", []string{"A"}, []string{"B"}},
+		{"A Feat. B; C", []string{"A"}, []string{"B", "C"}},
+		{"A ft. AC/DC\x00D", []string{"A", "D"}, []string{"AC/DC"}},
+	}
+
+	for _, tt := range tests {
+		artists, featured := DefaultArtistSplitter.SplitFeatured(tt.value)
+		if !reflect.DeepEqual(artists, tt.artists) || !reflect.DeepEqual(featured, tt.featured) {
+			t.Errorf("SplitFeatured(%q) = %q, %q, want %q, %q", tt.value, artists, featured, tt.artists, tt.featured)
+		}
+	}
+
+	if got, want := DefaultArtistSplitter.Split("A feat. B / C"), []string{"A", "B", "C"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Split() = %q, want %q", got, want)
+	}
+}
@@ -83,6 +83,16 @@ func commands() []cli.Command {
 			Usage:  "Return track number and position",
 			Action: commandTrackNumberAndPosition,
 		},
+		{
+			Name:   "disc-number-and-total",
+			Usage:  "Return disc number and total discs",
+			Action: commandDiscNumberAndTotal,
+		},
+		{
+			Name:   "set-subtitle",
+			Usage:  "Return set subtitle",
+			Action: commandSetSubtitle,
+		},
 	}
 }
 
@@ -284,12 +294,61 @@ func commandTrackNumberAndPosition(c *cli.Context) error {
 		return fmt.Errorf("error on new id3: %w", err)
 	}
 
-	a, b := tag.TrackNumberAndPosition()
+	a, b, ok := tag.TrackNumberAndPosition()
+	if !ok {
+		fmt.Println()
+
+		return nil
+	}
+
 	fmt.Printf("%d/%d\n", a, b)
 
 	return nil
 }
 
+func commandDiscNumberAndTotal(c *cli.Context) error {
+	f, err := os.Open(c.Args().First())
+	if err != nil {
+		return fmt.Errorf("error on open file: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	tag, err := id3.New(f)
+	if err != nil {
+		return fmt.Errorf("error on new id3: %w", err)
+	}
+
+	a, b, ok := tag.DiscNumberAndTotal()
+	if !ok {
+		fmt.Println()
+
+		return nil
+	}
+
+	fmt.Printf("%d/%d\n", a, b)
+
+	return nil
+}
+
+func commandSetSubtitle(c *cli.Context) error {
+	f, err := os.Open(c.Args().First())
+	if err != nil {
+		return fmt.Errorf("error on open file: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	tag, err := id3.New(f)
+	if err != nil {
+		return fmt.Errorf("error on new id3: %w", err)
+	}
+
+	fmt.Println(tag.SetSubtitle())
+
+	return nil
+}
+
 func commandGenres(c *cli.Context) error {
 	f, err := os.Open(c.Args().First())
 	if err != nil {
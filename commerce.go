@@ -0,0 +1,127 @@
+package id3
+
+import (
+	"time"
+
+	"github.com/xonyagar/id3/lib"
+)
+
+const commerceDateLayout = "20060102"
+
+// CommercialOffer is an offer of a commercial frame.
+type CommercialOffer struct {
+	Prices      []lib.Price
+	ValidUntil  time.Time
+	ContactURL  string
+	ReceivedAs  uint8
+	Seller      string
+	Description string
+	// SellerLogoMIMEType is "image/png" or "image/jpeg".
+	SellerLogoMIMEType string
+	SellerLogo         []byte
+}
+
+// Ownership is purchase information of an ownership frame.
+type Ownership struct {
+	PricePaid      lib.Price
+	DateOfPurchase time.Time
+	Seller         string
+}
+
+// Commerce is purchase and commercial information of a file.
+type Commerce struct {
+	Offers                []CommercialOffer
+	Ownerships            []Ownership
+	TermsOfUse            string
+	CommercialInformation []string
+	Payment               string
+}
+
+type commercial interface {
+	Prices() []lib.Price
+	ValidUntil() string
+	ContactURL() string
+	NameOfSeller() string
+	Description() string
+	PictureMIMEType() string
+	SellerLogo() []byte
+}
+
+type ownership interface {
+	PricePaid() string
+	DateOfPurchase() string
+	Seller() string
+}
+
+func newCommercialOffer(c commercial, receivedAs uint8) CommercialOffer {
+	validUntil, _ := time.Parse(commerceDateLayout, c.ValidUntil())
+
+	return CommercialOffer{
+		Prices:             c.Prices(),
+		ValidUntil:         validUntil,
+		ContactURL:         c.ContactURL(),
+		ReceivedAs:         receivedAs,
+		Seller:             c.NameOfSeller(),
+		Description:        c.Description(),
+		SellerLogoMIMEType: c.PictureMIMEType(),
+		SellerLogo:         c.SellerLogo(),
+	}
+}
+
+func newOwnership(o ownership) Ownership {
+	dateOfPurchase, _ := time.Parse(commerceDateLayout, o.DateOfPurchase())
+	ownership := Ownership{
+		DateOfPurchase: dateOfPurchase,
+		Seller:         o.Seller(),
+	}
+
+	if prices := lib.ParsePrices(o.PricePaid()); len(prices) > 0 {
+		ownership.PricePaid = prices[0]
+	}
+
+	return ownership
+}
+
+// Commerce will return commercial, ownership, terms of use and commercial URL frames of ID3v2.4 or ID3v2.3 tag.
+func (t ID3) Commerce() Commerce {
+	commerce := Commerce{
+		Offers:                []CommercialOffer{},
+		Ownerships:            []Ownership{},
+		CommercialInformation: []string{},
+	}
+
+	switch {
+	case t.V24 != nil:
+		for _, c := range t.V24.Commercials() {
+			commerce.Offers = append(commerce.Offers, newCommercialOffer(c, uint8(c.ReceivedAs())))
+		}
+
+		for _, o := range t.V24.Ownerships() {
+			commerce.Ownerships = append(commerce.Ownerships, newOwnership(o))
+		}
+
+		commerce.TermsOfUse = t.V24.TermsOfUse()
+		commerce.CommercialInformation = t.V24.URLs("WCOM")
+
+		if payments := t.V24.URLs("WPAY"); len(payments) > 0 {
+			commerce.Payment = payments[0]
+		}
+	case t.V23 != nil:
+		for _, c := range t.V23.Commercials() {
+			commerce.Offers = append(commerce.Offers, newCommercialOffer(c, uint8(c.ReceivedAs())))
+		}
+
+		for _, o := range t.V23.Ownerships() {
+			commerce.Ownerships = append(commerce.Ownerships, newOwnership(o))
+		}
+
+		commerce.TermsOfUse = t.V23.TermsOfUse()
+		commerce.CommercialInformation = t.V23.URLs("WCOM")
+
+		if payments := t.V23.URLs("WPAY"); len(payments) > 0 {
+			commerce.Payment = payments[0]
+		}
+	}
+
+	return commerce
+}
@@ -0,0 +1,568 @@
+package id3
+
+import "time"
+
+func (t ID3) Subtitle() string {
+	if t.V24 != nil {
+		if value := t.V24.Subtitle(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Subtitle(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Subtitle(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) Composer() string {
+	if t.V24 != nil {
+		if value := t.V24.Composer(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Composer(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Composer(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) Lyricist() string {
+	if t.V24 != nil {
+		if value := t.V24.Lyricist(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Lyricist(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Lyricist(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) Conductor() string {
+	if t.V24 != nil {
+		if value := t.V24.Conductor(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Conductor(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Conductor(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// RemixedBy will return who interpreted, remixed, or otherwise modified the track.
+func (t ID3) RemixedBy() string {
+	if t.V24 != nil {
+		if value := t.V24.RemixedBy(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.RemixedBy(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.RemixedBy(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// InitialKey will return musical key in which the sound starts, like "Dbm".
+func (t ID3) InitialKey() string {
+	if t.V24 != nil {
+		if value := t.V24.InitialKey(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.InitialKey(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.InitialKey(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// ISRC will return international standard recording code.
+func (t ID3) ISRC() string {
+	if t.V24 != nil {
+		if value := t.V24.ISRC(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.ISRC(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.ISRC(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) Publisher() string {
+	if t.V24 != nil {
+		if value := t.V24.Publisher(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Publisher(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Publisher(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// Copyright will return copyright message, like "2004 Label".
+func (t ID3) Copyright() string {
+	if t.V24 != nil {
+		if value := t.V24.Copyright(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Copyright(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Copyright(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) EncodedBy() string {
+	if t.V24 != nil {
+		if value := t.V24.EncodedBy(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.EncodedBy(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.EncodedBy(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// EncoderSettings will return software, hardware and settings used for encoding.
+func (t ID3) EncoderSettings() string {
+	if t.V24 != nil {
+		if value := t.V24.EncoderSettings(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.EncoderSettings(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.EncoderSettings(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// Language will return ISO 639-2 language code(s) of the audio.
+func (t ID3) Language() string {
+	if t.V24 != nil {
+		if value := t.V24.Language(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Language(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Language(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) Mood() string {
+	if t.V24 != nil {
+		if value := t.V24.Mood(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) MediaType() string {
+	if t.V24 != nil {
+		if value := t.V24.MediaType(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.MediaType(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.MediaType(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) FileType() string {
+	if t.V24 != nil {
+		if value := t.V24.FileType(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.FileType(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.FileType(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) OriginalAlbum() string {
+	if t.V24 != nil {
+		if value := t.V24.OriginalAlbum(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.OriginalAlbum(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.OriginalAlbum(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) OriginalArtist() string {
+	if t.V24 != nil {
+		if value := t.V24.OriginalArtist(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.OriginalArtist(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.OriginalArtist(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) OriginalLyricist() string {
+	if t.V24 != nil {
+		if value := t.V24.OriginalLyricist(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.OriginalLyricist(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.OriginalLyricist(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) OriginalFilename() string {
+	if t.V24 != nil {
+		if value := t.V24.OriginalFilename(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.OriginalFilename(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.OriginalFilename(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) FileOwner() string {
+	if t.V24 != nil {
+		if value := t.V24.FileOwner(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.FileOwner(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// RadioStationName will return internet radio station name.
+func (t ID3) RadioStationName() string {
+	if t.V24 != nil {
+		if value := t.V24.RadioStationName(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.RadioStationName(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// RadioStationOwner will return internet radio station owner.
+func (t ID3) RadioStationOwner() string {
+	if t.V24 != nil {
+		if value := t.V24.RadioStationOwner(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.RadioStationOwner(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) ProducedNotice() string {
+	if t.V24 != nil {
+		if value := t.V24.ProducedNotice(); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// BPM will return beats per minute, rounded to an integer.
+func (t ID3) BPM() int {
+	if t.V24 != nil {
+		if value := t.V24.BPM(); value != 0 {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.BPM(); value != 0 {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.BPM(); value != 0 {
+			return value
+		}
+	}
+
+	return 0
+}
+
+func (t ID3) Length() time.Duration {
+	if t.V24 != nil {
+		if value := t.V24.Length(); value != 0 {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Length(); value != 0 {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Length(); value != 0 {
+			return value
+		}
+	}
+
+	return 0
+}
+
+func (t ID3) PlaylistDelay() time.Duration {
+	if t.V24 != nil {
+		if value := t.V24.PlaylistDelay(); value != 0 {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.PlaylistDelay(); value != 0 {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.PlaylistDelay(); value != 0 {
+			return value
+		}
+	}
+
+	return 0
+}
+
+func (t ID3) Comment() string {
+	if t.V24 != nil {
+		if value := t.V24.Comment(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.Comment(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.Comment(); value != "" {
+			return value
+		}
+	}
+
+	if t.V1 != nil {
+		return t.V1.Comment()
+	}
+
+	return ""
+}
@@ -2,7 +2,11 @@ module github.com/xonyagar/id3
 
 go 1.17
 
-require github.com/urfave/cli v1.22.5
+require (
+	github.com/urfave/cli v1.22.5
+	golang.org/x/image v0.12.0
+	golang.org/x/text v0.13.0
+)
 
 require (
 	github.com/cpuguy83/go-md2man/v2 v2.0.0-20190314233015-f79a8a8ca69d // indirect
@@ -5,19 +5,24 @@ import (
 	"fmt"
 	"image"
 	"io"
-	"strconv"
+	"time"
 
+	"github.com/xonyagar/id3/lib"
 	v1 "github.com/xonyagar/id3/v1"
 	v22 "github.com/xonyagar/id3/v22"
 	v23 "github.com/xonyagar/id3/v23"
 	v24 "github.com/xonyagar/id3/v24"
 )
 
+var ErrMusicCDIdentifierNotFound = errors.New("no music cd identifier found")
+
 type ID3 struct {
 	V1  *v1.Tag
 	V22 *v22.Tag
 	V23 *v23.Tag
 	V24 *v24.Tag
+	// ArtistSplitter splits multiple artists of Artists and AlbumArtists, DefaultArtistSplitter is used when it is nil.
+	ArtistSplitter *ArtistSplitter
 }
 
 func New(f io.ReadSeeker) (*ID3, error) {
@@ -116,91 +121,142 @@ func (t ID3) Album() string {
 	return ""
 }
 
-func (t ID3) AlbumArtists() []string {
+// AlbumArtist will return album artist(s) as stored, without splitting multiple artists.
+func (t ID3) AlbumArtist() string {
 	if t.V24 != nil {
-		if albumArtists := t.V24.AlbumArtists(); len(albumArtists) > 0 {
-			return albumArtists
+		if albumArtist := t.V24.AlbumArtist(); albumArtist != "" {
+			return albumArtist
 		}
 	}
 
 	if t.V23 != nil {
-		if albumArtists := t.V23.AlbumArtists(); len(albumArtists) > 0 {
-			return albumArtists
+		if albumArtist := t.V23.AlbumArtist(); albumArtist != "" {
+			return albumArtist
 		}
 	}
 
 	if t.V22 != nil {
-		if albumArtists := t.V22.AlbumArtists(); len(albumArtists) > 0 {
-			return albumArtists
+		if albumArtist := t.V22.AlbumArtist(); albumArtist != "" {
+			return albumArtist
 		}
 	}
 
 	if t.V1 != nil {
-		if artist := t.V1.Artist(); artist != "" {
-			return []string{artist}
+		return t.V1.Artist()
+	}
+
+	return ""
+}
+
+// AlbumArtists will return album artists split by ArtistSplitter.
+func (t ID3) AlbumArtists() []string {
+	return t.artistSplitter().Split(t.AlbumArtist())
+}
+
+// Artist will return artist(s) as stored, without splitting multiple artists.
+func (t ID3) Artist() string {
+	if t.V24 != nil {
+		if artist := t.V24.Artist(); artist != "" {
+			return artist
 		}
 	}
 
-	return []string{}
+	if t.V23 != nil {
+		if artist := t.V23.Artist(); artist != "" {
+			return artist
+		}
+	}
+
+	if t.V22 != nil {
+		if artist := t.V22.Artist(); artist != "" {
+			return artist
+		}
+	}
+
+	if t.V1 != nil {
+		return t.V1.Artist()
+	}
+
+	return ""
 }
 
+// Artists will return artists split by ArtistSplitter.
 func (t ID3) Artists() []string {
+	return t.artistSplitter().Split(t.Artist())
+}
+
+// FeaturedArtists will return featured artists split by ArtistSplitter, like "B" of "A feat. B".
+func (t ID3) FeaturedArtists() []string {
+	_, featured := t.artistSplitter().SplitFeatured(t.Artist())
+
+	return featured
+}
+
+func (t ID3) TrackNumberAndPosition() (int, int, bool) {
 	if t.V24 != nil {
-		if artists := t.V24.Artists(); len(artists) > 0 {
-			return artists
+		if a, b, ok := t.V24.TrackNumberAndPosition(); ok {
+			return a, b, true
 		}
 	}
 
 	if t.V23 != nil {
-		if artists := t.V23.Artists(); len(artists) > 0 {
-			return artists
+		if a, b, ok := t.V23.TrackNumberAndPosition(); ok {
+			return a, b, true
 		}
 	}
 
 	if t.V22 != nil {
-		if artists := t.V22.Artists(); len(artists) > 0 {
-			return artists
+		if a, b, ok := t.V22.TrackNumberAndPosition(); ok {
+			return a, b, true
 		}
 	}
 
 	if t.V1 != nil {
-		if artist := t.V1.Artist(); artist != "" {
-			return []string{artist}
+		// ID3v1.1 track zero means there is no track.
+		if a, _, ok := lib.ParseNumberAndTotal(t.V1.AlbumTrack()); ok && a != 0 {
+			return a, 0, true
 		}
 	}
 
-	return []string{}
+	return 0, 0, false
 }
 
-func (t ID3) TrackNumberAndPosition() (int, int) {
+func (t ID3) DiscNumberAndTotal() (int, int, bool) {
 	if t.V24 != nil {
-		if a, b := t.V24.TrackNumberAndPosition(); a != 0 {
-			return a, b
+		if a, b, ok := t.V24.DiscNumberAndTotal(); ok {
+			return a, b, true
 		}
 	}
 
 	if t.V23 != nil {
-		if a, b := t.V23.TrackNumberAndPosition(); a != 0 {
-			return a, b
+		if a, b, ok := t.V23.DiscNumberAndTotal(); ok {
+			return a, b, true
 		}
 	}
 
 	if t.V22 != nil {
-		if a, b := t.V22.TrackNumberAndPosition(); a != 0 {
-			return a, b
+		if a, b, ok := t.V22.DiscNumberAndTotal(); ok {
+			return a, b, true
 		}
 	}
 
-	if t.V1 != nil {
-		if s := t.V1.AlbumTrack(); s != "" {
-			a, err := strconv.Atoi(s)
-			if err != nil {
-				return a, 0
-			}
+	return 0, 0, false
+}
+
+func (t ID3) SetSubtitle() string {
+	if t.V24 != nil {
+		if subtitle := t.V24.SetSubtitle(); subtitle != "" {
+			return subtitle
 		}
 	}
 
-	return 0, 0
+	if t.V23 != nil {
+		if subtitle := t.V23.SetSubtitle(); subtitle != "" {
+			return subtitle
+		}
+	}
+
+	return ""
 }
 
 func (t ID3) Year() string {
@@ -231,8 +287,137 @@ func (t ID3) Year() string {
 	return ""
 }
 
+// IsCompilation will return iTunes compilation flag.
+func (t ID3) IsCompilation() bool {
+	if t.V24 != nil && t.V24.IsCompilation() {
+		return true
+	}
+
+	if t.V23 != nil && t.V23.IsCompilation() {
+		return true
+	}
+
+	if t.V22 != nil && t.V22.IsCompilation() {
+		return true
+	}
+
+	return false
+}
+
+func (t ID3) Grouping() string {
+	if t.V24 != nil {
+		if grouping := t.V24.Grouping(); grouping != "" {
+			return grouping
+		}
+	}
+
+	if t.V23 != nil {
+		if grouping := t.V23.Grouping(); grouping != "" {
+			return grouping
+		}
+	}
+
+	if t.V22 != nil {
+		if grouping := t.V22.Grouping(); grouping != "" {
+			return grouping
+		}
+	}
+
+	return ""
+}
+
+// Movement will return movement name, movement number and movement count of classical works.
+func (t ID3) Movement() (string, int, int) {
+	if t.V24 != nil {
+		if name, number, count := t.V24.Movement(); name != "" || number != 0 {
+			return name, number, count
+		}
+	}
+
+	if t.V23 != nil {
+		if name, number, count := t.V23.Movement(); name != "" || number != 0 {
+			return name, number, count
+		}
+	}
+
+	return "", 0, 0
+}
+
+// RecordingTime will return recording time, ID3v1 year is used when no ID3v2 tag has one.
+func (t ID3) RecordingTime() lib.Timestamp {
+	if t.V24 != nil {
+		if ts := t.V24.RecordingTime(); !ts.IsZero() {
+			return ts
+		}
+	}
+
+	if t.V23 != nil {
+		if ts := t.V23.RecordingTime(); !ts.IsZero() {
+			return ts
+		}
+	}
+
+	if t.V22 != nil {
+		if ts := t.V22.RecordingTime(); !ts.IsZero() {
+			return ts
+		}
+	}
+
+	if t.V1 != nil {
+		if ts, err := lib.ParseTimestamp(t.V1.Year()); err == nil {
+			return ts
+		}
+	}
+
+	return lib.Timestamp{}
+}
+
+// ReleaseTime will return release time, which only ID3v2.4 has.
+func (t ID3) ReleaseTime() lib.Timestamp {
+	if t.V24 != nil {
+		return t.V24.ReleaseTime()
+	}
+
+	return lib.Timestamp{}
+}
+
+func (t ID3) OriginalReleaseTime() lib.Timestamp {
+	if t.V24 != nil {
+		if ts := t.V24.OriginalReleaseTime(); !ts.IsZero() {
+			return ts
+		}
+	}
+
+	if t.V23 != nil {
+		if ts := t.V23.OriginalReleaseTime(); !ts.IsZero() {
+			return ts
+		}
+	}
+
+	if t.V22 != nil {
+		if ts := t.V22.OriginalReleaseTime(); !ts.IsZero() {
+			return ts
+		}
+	}
+
+	return lib.Timestamp{}
+}
+
+// TaggingTime will return tagging time, which only ID3v2.4 has.
+func (t ID3) TaggingTime() lib.Timestamp {
+	if t.V24 != nil {
+		return t.V24.TaggingTime()
+	}
+
+	return lib.Timestamp{}
+}
+
 type AttachedPicture interface {
 	Image() (image.Image, error)
+	Type() lib.PictureType
+	MIMEType() string
+	Description() string
+	Data() []byte
 }
 
 func (t ID3) AttachedPictures() []AttachedPicture {
@@ -272,6 +457,57 @@ func (t ID3) AttachedPictures() []AttachedPicture {
 	return []AttachedPicture{}
 }
 
+// FrontCover will return the best cover of ID3v2.4, ID3v2.3 and ID3v2.2 pictures. Front covers are preferred,
+// then pictures of type other and then any picture except file icons, the largest picture wins between equals.
+func (t ID3) FrontCover() (AttachedPicture, bool) {
+	pics := make([]AttachedPicture, 0)
+
+	if t.V24 != nil {
+		for _, pic := range t.V24.AttachedPictures() {
+			pics = append(pics, pic)
+		}
+	}
+
+	if t.V23 != nil {
+		for _, pic := range t.V23.AttachedPictures() {
+			pics = append(pics, pic)
+		}
+	}
+
+	if t.V22 != nil {
+		for _, pic := range t.V22.AttachedPictures() {
+			pics = append(pics, pic)
+		}
+	}
+
+	var best AttachedPicture
+
+	bestRank := coverRank(v24.PictureType32x32)
+
+	for _, pic := range pics {
+		rank := coverRank(pic.Type())
+		if rank < bestRank || (best != nil && rank == bestRank && len(pic.Data()) > len(best.Data())) {
+			best, bestRank = pic, rank
+		}
+	}
+
+	return best, best != nil
+}
+
+// coverRank will return rank of picture type as cover, lower is better and file icons are not covers.
+func coverRank(pictureType lib.PictureType) int {
+	switch pictureType {
+	case v24.PictureTypeCoverFront:
+		return 0
+	case v24.PictureTypeOther:
+		return 1
+	case v24.PictureType32x32, v24.PictureTypeOtherFileIcon:
+		return 3
+	default:
+		return 2
+	}
+}
+
 func (t ID3) Genres() []string {
 	if t.V24 != nil {
 		if genres := t.V24.Genres(); len(genres) > 0 {
@@ -299,3 +535,269 @@ func (t ID3) Genres() []string {
 
 	return []string{}
 }
+
+type GeneralEncapsulatedObject interface {
+	MIMEType() string
+	Filename() string
+	Description() string
+	Data() []byte
+	Extract(dir string) (string, error)
+}
+
+func (t ID3) GeneralEncapsulatedObjects() []GeneralEncapsulatedObject {
+	if t.V24 != nil {
+		if objects := t.V24.GeneralEncapsulatedObjects(); len(objects) > 0 {
+			res := make([]GeneralEncapsulatedObject, len(objects))
+			for i := range objects {
+				res[i] = objects[i]
+			}
+
+			return res
+		}
+	}
+
+	if t.V23 != nil {
+		if objects := t.V23.GeneralEncapsulatedObjects(); len(objects) > 0 {
+			res := make([]GeneralEncapsulatedObject, len(objects))
+			for i := range objects {
+				res[i] = objects[i]
+			}
+
+			return res
+		}
+	}
+
+	if t.V22 != nil {
+		if objects := t.V22.GeneralEncapsulatedObjects(); len(objects) > 0 {
+			res := make([]GeneralEncapsulatedObject, len(objects))
+			for i := range objects {
+				res[i] = objects[i]
+			}
+
+			return res
+		}
+	}
+
+	return []GeneralEncapsulatedObject{}
+}
+
+// ExtractGeneralEncapsulatedObjects will write all encapsulated objects into dir and return written file paths,
+// objects with the same filename are written to numbered files, like "notes (1).txt".
+func (t ID3) ExtractGeneralEncapsulatedObjects(dir string) ([]string, error) {
+	objects := t.GeneralEncapsulatedObjects()
+	paths := make([]string, 0, len(objects))
+
+	for i := range objects {
+		path, err := objects[i].Extract(dir)
+		if err != nil {
+			return paths, fmt.Errorf("error on extract general encapsulated object: %w", err)
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func (t ID3) Ratings() []Rating {
+	if t.V24 != nil {
+		if pops := t.V24.Popularimeters(); len(pops) > 0 {
+			res := make([]popularimeter, len(pops))
+			for i := range pops {
+				res[i] = pops[i]
+			}
+
+			return newRatings(res)
+		}
+	}
+
+	if t.V23 != nil {
+		if pops := t.V23.Popularimeters(); len(pops) > 0 {
+			res := make([]popularimeter, len(pops))
+			for i := range pops {
+				res[i] = pops[i]
+			}
+
+			return newRatings(res)
+		}
+	}
+
+	if t.V22 != nil {
+		if pops := t.V22.Popularimeters(); len(pops) > 0 {
+			res := make([]popularimeter, len(pops))
+			for i := range pops {
+				res[i] = pops[i]
+			}
+
+			return newRatings(res)
+		}
+	}
+
+	return []Rating{}
+}
+
+func (t ID3) PlayCounter() int {
+	if t.V24 != nil {
+		if counter := t.V24.PlayCounter(); counter != 0 {
+			return counter
+		}
+	}
+
+	if t.V23 != nil {
+		if counter := t.V23.PlayCounter(); counter != 0 {
+			return counter
+		}
+	}
+
+	if t.V22 != nil {
+		if counter := t.V22.PlayCounter(); counter != 0 {
+			return counter
+		}
+	}
+
+	return 0
+}
+
+// Credit is a role of a person, like "mixer" or "guitar".
+type Credit struct {
+	Role   string
+	Person string
+}
+
+func (t ID3) Credits() []Credit {
+	if t.V24 != nil {
+		if people := t.V24.InvolvedPeople(); len(people) > 0 {
+			res := make([]Credit, len(people))
+			for i := range people {
+				res[i] = Credit{Role: people[i].Involvement, Person: people[i].Involvee}
+			}
+
+			return res
+		}
+	}
+
+	if t.V23 != nil {
+		if people := t.V23.InvolvedPeople(); len(people) > 0 {
+			res := make([]Credit, len(people))
+			for i := range people {
+				res[i] = Credit{Role: people[i].Involvement, Person: people[i].Involvee}
+			}
+
+			return res
+		}
+	}
+
+	if t.V22 != nil {
+		if people := t.V22.InvolvedPeople(); len(people) > 0 {
+			res := make([]Credit, len(people))
+			for i := range people {
+				res[i] = Credit{Role: people[i].Involvement, Person: people[i].Involvee}
+			}
+
+			return res
+		}
+	}
+
+	return []Credit{}
+}
+
+// Musicians will return musician credits with instrument as role, only ID3v2.4 has separate musician credits list.
+func (t ID3) Musicians() []Credit {
+	if t.V24 != nil {
+		if people := t.V24.MusicianCredits(); len(people) > 0 {
+			res := make([]Credit, len(people))
+			for i := range people {
+				res[i] = Credit{Role: people[i].Involvement, Person: people[i].Involvee}
+			}
+
+			return res
+		}
+	}
+
+	return []Credit{}
+}
+
+func (t ID3) MusicCDIdentifier() (lib.TOC, error) {
+	if t.V24 != nil {
+		if toc := t.V24.MusicCDIdentifier(); len(toc) > 0 {
+			return lib.ParseTOC(toc)
+		}
+	}
+
+	if t.V23 != nil {
+		if toc := t.V23.MusicCDIdentifier(); len(toc) > 0 {
+			return lib.ParseTOC(toc)
+		}
+	}
+
+	if t.V22 != nil {
+		if toc := t.V22.MusicCDIdentifier(); len(toc) > 0 {
+			return lib.ParseTOC(toc)
+		}
+	}
+
+	return lib.TOC{}, ErrMusicCDIdentifierNotFound
+}
+
+// SeekOffset will return file offset of audio position d, using MPEG location lookup table.
+func (t ID3) SeekOffset(d time.Duration) (int, bool) {
+	if t.V24 != nil {
+		if offset, ok := t.V24.SeekOffset(d); ok {
+			return offset, true
+		}
+	}
+
+	if t.V23 != nil {
+		if offset, ok := t.V23.SeekOffset(d); ok {
+			return offset, true
+		}
+	}
+
+	if t.V22 != nil {
+		if offset, ok := t.V22.SeekOffset(d); ok {
+			return offset, true
+		}
+	}
+
+	return 0, false
+}
+
+// Decrypt will decrypt encrypted frames of ID3v2.4 and ID3v2.3 tags using d.
+func (t ID3) Decrypt(d lib.Decrypter) error {
+	if t.V24 != nil {
+		if err := t.V24.Decrypt(d); err != nil {
+			return fmt.Errorf("error on decrypt id3v2.4 tag: %w", err)
+		}
+	}
+
+	if t.V23 != nil {
+		if err := t.V23.Decrypt(d); err != nil {
+			return fmt.Errorf("error on decrypt id3v2.3 tag: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ResolveLinks will replace linked information frames of ID3v2 tags by the frames they refer to.
+func (t ID3) ResolveLinks(r lib.LinkResolver) error {
+	if t.V24 != nil {
+		if err := t.V24.ResolveLinks(r); err != nil {
+			return fmt.Errorf("error on resolve links of id3v2.4 tag: %w", err)
+		}
+	}
+
+	if t.V23 != nil {
+		if err := t.V23.ResolveLinks(r); err != nil {
+			return fmt.Errorf("error on resolve links of id3v2.3 tag: %w", err)
+		}
+	}
+
+	if t.V22 != nil {
+		if err := t.V22.ResolveLinks(r); err != nil {
+			return fmt.Errorf("error on resolve links of id3v2.2 tag: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package id3
+
+import (
+	"bytes"
+	"testing"
+
+	v1 "github.com/xonyagar/id3/v1"
+)
+
+// newV1Tag will return ID3v1.1 tag with album track, track zero is an ID3v1.1 tag without track.
+func newV1Tag(t *testing.T, track byte) *v1.Tag {
+	t.Helper()
+
+	b := make([]byte, v1.TagSize)
+	copy(b, "TAG")
+	b[126] = track
+
+	tag, err := v1.New(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("error on new v1 tag: %v", err)
+	}
+
+	return tag
+}
+
+func TestTrackNumberAndPositionV1(t *testing.T) {
+	tests := []struct {
+		track  byte
+		number int
+		ok     bool
+	}{
+		{7, 7, true},
+		{255, 255, true},
+		{0, 0, false},
+	}
+
+	for _, tt := range tests {
+		number, total, ok := ID3{V1: newV1Tag(t, tt.track)}.TrackNumberAndPosition()
+		if number != tt.number || total != 0 || ok != tt.ok {
+			t.Errorf("TrackNumberAndPosition() of track %d = %d, %d, %v, want %d, 0, %v",
+				tt.track, number, total, ok, tt.number, tt.ok)
+		}
+	}
+
+	if _, _, ok := (ID3{}).TrackNumberAndPosition(); ok {
+		t.Error("TrackNumberAndPosition() of empty tag is present")
+	}
+}
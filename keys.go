@@ -0,0 +1,353 @@
+package id3
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/xonyagar/id3/lib"
+	v1 "github.com/xonyagar/id3/v1"
+	v24 "github.com/xonyagar/id3/v24"
+)
+
+// fieldKind is how values of a key are stored in frames.
+type fieldKind int
+
+const (
+	fieldText fieldKind = iota
+	fieldUserDefinedText
+	fieldUniqueFileIdentifier
+	fieldNumber
+	fieldTotal
+	fieldGenre
+	fieldCompilation
+	fieldComment
+	fieldLyrics
+	fieldDate
+)
+
+// field is storage of a key. IDs are frame ids of ID3v2.4, ID3v2.3 and ID3v2.2 tags, a version without frame id
+// stores key as user defined text. Description is description of user defined text or owner of unique file identifier.
+// Frame ids of dates are frames of dates which are not timestamps, like ID3v2.3 recording dates (TRDA).
+type field struct {
+	kind        fieldKind
+	ids         [3]string
+	description string
+}
+
+var keyFields = map[string]field{
+	"TITLE":           {kind: fieldText, ids: [3]string{"TIT2", "TIT2", "TT2"}},
+	"SUBTITLE":        {kind: fieldText, ids: [3]string{"TIT3", "TIT3", "TT3"}},
+	"GROUPING":        {kind: fieldText, ids: [3]string{"TIT1", "TIT1", "TT1"}},
+	"ARTIST":          {kind: fieldText, ids: [3]string{"TPE1", "TPE1", "TP1"}},
+	"ALBUM":           {kind: fieldText, ids: [3]string{"TALB", "TALB", "TAL"}},
+	"ALBUMARTIST":     {kind: fieldText, ids: [3]string{"TPE2", "TPE2", "TP2"}},
+	"DATE":            {kind: fieldDate, ids: [3]string{"TDRC", "TRDA", "TRD"}},
+	"ORIGINALDATE":    {kind: fieldText, ids: [3]string{"TDOR", "TORY", "TOR"}},
+	"RELEASEDATE":     {kind: fieldText, ids: [3]string{"TDRL", "", ""}},
+	"TRACKNUMBER":     {kind: fieldNumber, ids: [3]string{"TRCK", "TRCK", "TRK"}},
+	"TRACKTOTAL":      {kind: fieldTotal, ids: [3]string{"TRCK", "TRCK", "TRK"}},
+	"TOTALTRACKS":     {kind: fieldTotal, ids: [3]string{"TRCK", "TRCK", "TRK"}},
+	"DISCNUMBER":      {kind: fieldNumber, ids: [3]string{"TPOS", "TPOS", "TPA"}},
+	"DISCTOTAL":       {kind: fieldTotal, ids: [3]string{"TPOS", "TPOS", "TPA"}},
+	"TOTALDISCS":      {kind: fieldTotal, ids: [3]string{"TPOS", "TPOS", "TPA"}},
+	"DISCSUBTITLE":    {kind: fieldText, ids: [3]string{"TSST", "TSST", ""}},
+	"COMPOSER":        {kind: fieldText, ids: [3]string{"TCOM", "TCOM", "TCM"}},
+	"LYRICIST":        {kind: fieldText, ids: [3]string{"TEXT", "TEXT", "TXT"}},
+	"CONDUCTOR":       {kind: fieldText, ids: [3]string{"TPE3", "TPE3", "TP3"}},
+	"REMIXER":         {kind: fieldText, ids: [3]string{"TPE4", "TPE4", "TP4"}},
+	"GENRE":           {kind: fieldGenre, ids: [3]string{"TCON", "TCON", "TCO"}},
+	"BPM":             {kind: fieldText, ids: [3]string{"TBPM", "TBPM", "TBP"}},
+	"KEY":             {kind: fieldText, ids: [3]string{"TKEY", "TKEY", "TKE"}},
+	"ISRC":            {kind: fieldText, ids: [3]string{"TSRC", "TSRC", "TRC"}},
+	"LABEL":           {kind: fieldText, ids: [3]string{"TPUB", "TPUB", "TPB"}},
+	"COPYRIGHT":       {kind: fieldText, ids: [3]string{"TCOP", "TCOP", "TCR"}},
+	"ENCODEDBY":       {kind: fieldText, ids: [3]string{"TENC", "TENC", "TEN"}},
+	"ENCODERSETTINGS": {kind: fieldText, ids: [3]string{"TSSE", "TSSE", "TSS"}},
+	"LANGUAGE":        {kind: fieldText, ids: [3]string{"TLAN", "TLAN", "TLA"}},
+	"COMMENT":         {kind: fieldComment, ids: [3]string{"COMM", "COMM", "COM"}},
+	"LYRICS":          {kind: fieldLyrics, ids: [3]string{"USLT", "USLT", "ULT"}},
+	"MOOD":            {kind: fieldText, ids: [3]string{"TMOO", "", ""}},
+	"MEDIA":           {kind: fieldText, ids: [3]string{"TMED", "TMED", "TMT"}},
+	"COMPILATION":     {kind: fieldCompilation, ids: [3]string{"TCMP", "TCMP", "TCP"}},
+	"ALBUMSORT":       {kind: fieldText, ids: [3]string{"TSOA", "XSOA", "TSA"}},
+	"ARTISTSORT":      {kind: fieldText, ids: [3]string{"TSOP", "XSOP", "TSP"}},
+	"TITLESORT":       {kind: fieldText, ids: [3]string{"TSOT", "XSOT", "TST"}},
+	"ALBUMARTISTSORT": {kind: fieldText, ids: [3]string{"TSO2", "TSO2", "TS2"}},
+	"COMPOSERSORT":    {kind: fieldText, ids: [3]string{"TSOC", "TSOC", "TSC"}},
+	"MOVEMENTNAME":    {kind: fieldText, ids: [3]string{"MVNM", "MVNM", ""}},
+	"MOVEMENTNUMBER":  {kind: fieldNumber, ids: [3]string{"MVIN", "MVIN", ""}},
+	"MOVEMENTTOTAL":   {kind: fieldTotal, ids: [3]string{"MVIN", "MVIN", ""}},
+
+	"MUSICBRAINZ_TRACKID":        {kind: fieldUniqueFileIdentifier, description: MusicBrainzOwnerIdentifier},
+	"MUSICBRAINZ_RELEASETRACKID": {kind: fieldUserDefinedText, description: "MusicBrainz Release Track Id"},
+	"MUSICBRAINZ_ALBUMID":        {kind: fieldUserDefinedText, description: "MusicBrainz Album Id"},
+	"MUSICBRAINZ_ARTISTID":       {kind: fieldUserDefinedText, description: "MusicBrainz Artist Id"},
+	"MUSICBRAINZ_ALBUMARTISTID":  {kind: fieldUserDefinedText, description: "MusicBrainz Album Artist Id"},
+	"MUSICBRAINZ_RELEASEGROUPID": {kind: fieldUserDefinedText, description: "MusicBrainz Release Group Id"},
+	"MUSICBRAINZ_WORKID":         {kind: fieldUserDefinedText, description: "MusicBrainz Work Id"},
+	"MUSICBRAINZ_DISCID":         {kind: fieldUserDefinedText, description: "MusicBrainz Disc Id"},
+	"RELEASESTATUS":              {kind: fieldUserDefinedText, description: "MusicBrainz Album Status"},
+	"RELEASETYPE":                {kind: fieldUserDefinedText, description: "MusicBrainz Album Type"},
+	"RELEASECOUNTRY":             {kind: fieldUserDefinedText, description: "MusicBrainz Album Release Country"},
+	"ACOUSTID_ID":                {kind: fieldUserDefinedText, description: "Acoustid Id"},
+	"ACOUSTID_FINGERPRINT":       {kind: fieldUserDefinedText, description: "Acoustid Fingerprint"},
+}
+
+var v1Fields = map[string]func(*v1.Tag) string{
+	"TITLE":       (*v1.Tag).Title,
+	"ARTIST":      (*v1.Tag).Artist,
+	"ALBUM":       (*v1.Tag).Album,
+	"DATE":        (*v1.Tag).Year,
+	"TRACKNUMBER": (*v1.Tag).AlbumTrack,
+	"GENRE":       (*v1.Tag).Genre,
+	"COMMENT":     (*v1.Tag).Comment,
+}
+
+// fieldTag is an ID3v2 tag of any version.
+type fieldTag interface {
+	Texts(id string) []string
+	UserDefinedTextInformation(description string) string
+	UniqueFileIdentifier(owner string) []byte
+	Genres() []string
+	IsCompilation() bool
+	Comment() string
+	Lyrics() string
+	RecordingTime() lib.Timestamp
+	SetText(id string, values ...string)
+	SetGenres(genres ...string)
+	SetComment(text string)
+	SetLyrics(lyrics string)
+	SetRecordingTime(ts lib.Timestamp)
+	SetUserDefinedTextInformation(description string, values ...string)
+	SetUniqueFileIdentifier(ownerIdentifier string, identifier []byte)
+}
+
+// fieldTags will return ID3v2 tags in the order of ID3v2.4, ID3v2.3 and ID3v2.2, index is index of frame ids of field.
+func (t ID3) fieldTags() ([]fieldTag, []int) {
+	tags, indexes := make([]fieldTag, 0, 3), make([]int, 0, 3)
+
+	if t.V24 != nil {
+		tags, indexes = append(tags, t.V24), append(indexes, 0)
+	}
+
+	if t.V23 != nil {
+		tags, indexes = append(tags, t.V23), append(indexes, 1)
+	}
+
+	if t.V22 != nil {
+		tags, indexes = append(tags, t.V22), append(indexes, 2)
+	}
+
+	return tags, indexes
+}
+
+// keyField will return field of key, keys without a field are user defined texts with key as description.
+func keyField(key string) field {
+	key = strings.ToUpper(key)
+
+	f, ok := keyFields[key]
+	if !ok {
+		return field{kind: fieldUserDefinedText, description: key}
+	}
+
+	if f.description == "" {
+		f.description = key
+	}
+
+	return f
+}
+
+func (f field) get(tag fieldTag, index int) []string {
+	id := f.ids[index]
+
+	switch {
+	case f.kind == fieldUniqueFileIdentifier:
+		if identifier := trimNull(string(tag.UniqueFileIdentifier(f.description))); identifier != "" {
+			return []string{identifier}
+		}
+
+		return []string{}
+	case f.kind == fieldUserDefinedText || id == "":
+		return userDefinedTexts(tag, f.description)
+	case f.kind == fieldGenre:
+		return tag.Genres()
+	case f.kind == fieldCompilation:
+		if tag.IsCompilation() {
+			return []string{"1"}
+		}
+
+		return []string{}
+	case f.kind == fieldComment:
+		return nonEmpty(tag.Comment())
+	case f.kind == fieldLyrics:
+		return nonEmpty(tag.Lyrics())
+	case f.kind == fieldDate:
+		if ts := tag.RecordingTime(); !ts.IsZero() {
+			return []string{ts.String()}
+		}
+
+		if texts := tag.Texts(id); len(texts) > 0 {
+			return texts
+		}
+
+		return userDefinedTexts(tag, f.description)
+	case f.kind == fieldNumber || f.kind == fieldTotal:
+		number, total := splitNumberAndTotal(tag.Texts(id))
+
+		value := number
+		if f.kind == fieldTotal {
+			value = total
+		}
+
+		if value == "" {
+			return []string{}
+		}
+
+		return []string{value}
+	default:
+		return tag.Texts(id)
+	}
+}
+
+func (f field) set(tag fieldTag, index int, values []string) {
+	id := f.ids[index]
+
+	switch {
+	case f.kind == fieldUniqueFileIdentifier:
+		tag.SetUniqueFileIdentifier(f.description, []byte(firstValue(values)))
+	case f.kind == fieldUserDefinedText || id == "":
+		tag.SetUserDefinedTextInformation(f.description, values...)
+	case f.kind == fieldGenre:
+		tag.SetGenres(values...)
+	case f.kind == fieldComment:
+		tag.SetComment(firstValue(values))
+	case f.kind == fieldLyrics:
+		tag.SetLyrics(firstValue(values))
+	case f.kind == fieldDate:
+		// Dates which are not timestamps are kept as user defined text, like "recorded in summer 2004".
+		ts, err := lib.ParseTimestamp(firstValue(values))
+		tag.SetRecordingTime(ts)
+
+		if err == nil {
+			values = nil
+		}
+
+		tag.SetUserDefinedTextInformation(f.description, values...)
+	case f.kind == fieldNumber || f.kind == fieldTotal:
+		number, total := splitNumberAndTotal(tag.Texts(id))
+		value := firstValue(values)
+
+		if f.kind == fieldNumber {
+			number = value
+		} else {
+			total = value
+		}
+
+		switch {
+		case number == "":
+			tag.SetText(id)
+		case total == "":
+			tag.SetText(id, number)
+		default:
+			tag.SetText(id, number+"/"+total)
+		}
+	default:
+		tag.SetText(id, values...)
+	}
+}
+
+// splitNumberAndTotal splits the first text of a "number/total" frame, like "01/12" or "1 of 12".
+// Texts which are not numbers, like vinyl side "A1", are split at "/".
+func splitNumberAndTotal(texts []string) (string, string) {
+	if len(texts) == 0 {
+		return "", ""
+	}
+
+	if number, total, ok := lib.ParseNumberAndTotal(texts[0]); ok {
+		if total == 0 {
+			return strconv.Itoa(number), ""
+		}
+
+		return strconv.Itoa(number), strconv.Itoa(total)
+	}
+
+	parts := strings.SplitN(texts[0], "/", 2)
+	if len(parts) < 2 {
+		return strings.TrimSpace(parts[0]), ""
+	}
+
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// userDefinedTexts will return non-empty values of user defined text with description.
+func userDefinedTexts(tag fieldTag, description string) []string {
+	values := make([]string, 0)
+
+	for _, value := range strings.Split(tag.UserDefinedTextInformation(description), "\x00") {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// firstValue will return the first value, or an empty string without values.
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// nonEmpty will return value as values, without empty value.
+func nonEmpty(value string) []string {
+	if value == "" {
+		return []string{}
+	}
+
+	return []string{value}
+}
+
+// Get will return values of a vorbis comment style key, like "ARTIST", "TRACKNUMBER" or "MUSICBRAINZ_ALBUMID".
+// Keys are case-insensitive, keys without a mapping (like "REPLAYGAIN_TRACK_GAIN") are user defined texts
+// with key as description. Tags are checked in the order of ID3v2.4, ID3v2.3, ID3v2.2 and ID3v1.
+func (t ID3) Get(key string) []string {
+	f := keyField(key)
+	tags, indexes := t.fieldTags()
+
+	for i := range tags {
+		if values := f.get(tags[i], indexes[i]); len(values) > 0 {
+			return values
+		}
+	}
+
+	if get, ok := v1Fields[strings.ToUpper(key)]; ok && t.V1 != nil {
+		if value := get(t.V1); value != "" && value != "0" {
+			return []string{value}
+		}
+	}
+
+	return []string{}
+}
+
+// Set will replace values of a vorbis comment style key in ID3v2 tags, see Get for keys.
+// Without values the key is removed, otherwise an ID3v2.4 tag is added when there is no ID3v2 tag.
+// Only the tags in memory are changed, not the file.
+func (t *ID3) Set(key string, values []string) {
+	if t.V24 == nil && t.V23 == nil && t.V22 == nil {
+		if len(values) == 0 {
+			return
+		}
+
+		t.V24 = new(v24.Tag)
+	}
+
+	f := keyField(key)
+	tags, indexes := t.fieldTags()
+
+	for i := range tags {
+		f.set(tags[i], indexes[i], values)
+	}
+}
@@ -0,0 +1,69 @@
+package id3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitNumberAndTotal(t *testing.T) {
+	tests := []struct {
+		texts  []string
+		number string
+		total  string
+	}{
+		{nil, "", ""},
+		{[]string{"01"}, "1", ""},
+		{[]string{"1/2"}, "1", "2"},
+		{[]string{"1 of 2"}, "1", "2"},
+		{[]string{"A1"}, "A1", ""},
+		{[]string{"A1/B2"}, "A1", "B2"},
+	}
+
+	for _, tt := range tests {
+		number, total := splitNumberAndTotal(tt.texts)
+		if number != tt.number || total != tt.total {
+			t.Errorf("splitNumberAndTotal(%q) = %q, %q, want %q, %q", tt.texts, number, total, tt.number, tt.total)
+		}
+	}
+}
+
+func TestSetDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+		tdrc  []string
+		txxx  string
+	}{
+		{"2004-06-01", []string{"2004-06-01"}, []string{"2004-06-01"}, ""},
+		{"summer 2004", []string{"summer 2004"}, []string{}, "summer 2004"},
+	}
+
+	for _, tt := range tests {
+		var tag ID3
+
+		tag.Set("DATE", []string{"recorded in 2003"})
+		tag.Set("DATE", []string{tt.value})
+
+		if got := tag.Get("DATE"); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Get(\"DATE\") after Set(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+
+		if got := tag.V24.Texts("TDRC"); !reflect.DeepEqual(got, tt.tdrc) {
+			t.Errorf("TDRC after Set(%q) = %q, want %q", tt.value, got, tt.tdrc)
+		}
+
+		if got := tag.V24.UserDefinedTextInformation("DATE"); got != tt.txxx {
+			t.Errorf("TXXX DATE after Set(%q) = %q, want %q", tt.value, got, tt.txxx)
+		}
+	}
+}
+
+func TestSetWithoutValuesAndTag(t *testing.T) {
+	var tag ID3
+
+	tag.Set("TITLE", nil)
+
+	if tag.V24 != nil {
+		t.Errorf("Set(\"TITLE\", nil) added an ID3v2.4 tag")
+	}
+}
@@ -0,0 +1,16 @@
+package lib
+
+// Bits will return n bits of data, starting from bit offset, most significant bit first.
+func Bits(data []byte, offset, n int) int {
+	res := 0
+
+	for i := offset; i < offset+n; i++ {
+		res <<= 1
+
+		if i/8 < len(data) && data[i/8]&(0x80>>(i%8)) != 0 {
+			res |= 1
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,143 @@
+package lib
+
+import (
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// SectorsPerSecond is count of CD sectors (frames) in a second.
+	SectorsPerSecond = 75
+	// PregapSectors is count of sectors before the first track, which LBA addresses do not include.
+	PregapSectors = 150
+	// DataTrackGapSectors is gap between last audio track and data track of an enhanced CD.
+	DataTrackGapSectors = 11400
+
+	tocHeaderSize     = 4
+	tocDescriptorSize = 8
+	tocLeadOutTrack   = 0xaa
+	tocDataTrackFlag  = 0x04
+)
+
+var ErrInvalidTOC = errors.New("invalid cd table of contents")
+
+// TOCTrack is a track of CD table of contents.
+type TOCTrack struct {
+	Number int
+	// Offset is LBA address of track in sectors.
+	Offset int
+	Data   bool
+}
+
+// TOC is CD table of contents, as stored in music CD identifier frame.
+type TOC struct {
+	FirstTrack int
+	LastTrack  int
+	Tracks     []TOCTrack
+	// LeadOut is LBA address of lead-out in sectors.
+	LeadOut int
+}
+
+// ParseTOC will parse binary table of contents, as returned by CD-ROM "READ TOC" command with LBA addresses.
+func ParseTOC(data []byte) (TOC, error) {
+	if len(data) < tocHeaderSize || (len(data)-tocHeaderSize)%tocDescriptorSize != 0 {
+		return TOC{}, fmt.Errorf("%w: size '%d'", ErrInvalidTOC, len(data))
+	}
+
+	toc := TOC{
+		FirstTrack: int(data[2]),
+		LastTrack:  int(data[3]),
+		Tracks:     make([]TOCTrack, 0),
+	}
+
+	leadOut := false
+
+	for i := tocHeaderSize; i < len(data); i += tocDescriptorSize {
+		descriptor := data[i : i+tocDescriptorSize]
+		number := int(descriptor[2])
+		offset := int(int32(binary.BigEndian.Uint32(descriptor[4:8])))
+
+		if number == tocLeadOutTrack {
+			toc.LeadOut = offset
+			leadOut = true
+
+			continue
+		}
+
+		toc.Tracks = append(toc.Tracks, TOCTrack{
+			Number: number,
+			Offset: offset,
+			Data:   descriptor[1]&tocDataTrackFlag == tocDataTrackFlag,
+		})
+	}
+
+	if !leadOut || len(toc.Tracks) == 0 {
+		return TOC{}, fmt.Errorf("%w: no lead-out or tracks", ErrInvalidTOC)
+	}
+
+	return toc, nil
+}
+
+// Length will return length of disc in seconds.
+func (t TOC) Length() int {
+	return (t.LeadOut + PregapSectors) / SectorsPerSecond
+}
+
+// FreeDBID will return FreeDB (CDDB) disc id of table of contents.
+func (t TOC) FreeDBID() string {
+	if len(t.Tracks) == 0 {
+		return ""
+	}
+
+	n := 0
+
+	for _, track := range t.Tracks {
+		for s := (track.Offset + PregapSectors) / SectorsPerSecond; s > 0; s /= 10 {
+			n += s % 10
+		}
+	}
+
+	length := t.Length() - (t.Tracks[0].Offset+PregapSectors)/SectorsPerSecond
+
+	return fmt.Sprintf("%08x", (n%0xff)<<24|length<<8|len(t.Tracks))
+}
+
+// MusicBrainzDiscID will return MusicBrainz disc id of table of contents.
+// Data track at the end of an enhanced CD is not a part of MusicBrainz disc id.
+func (t TOC) MusicBrainzDiscID() string {
+	tracks := t.Tracks
+	leadOut := t.LeadOut
+
+	if len(tracks) > 1 && tracks[len(tracks)-1].Data {
+		leadOut = tracks[len(tracks)-1].Offset - DataTrackGapSectors
+		tracks = tracks[:len(tracks)-1]
+	}
+
+	if len(tracks) == 0 {
+		return ""
+	}
+
+	offsets := make([]int, 100)
+	offsets[0] = leadOut + PregapSectors
+
+	for _, track := range tracks {
+		if track.Number > 0 && track.Number < len(offsets) {
+			offsets[track.Number] = track.Offset + PregapSectors
+		}
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("%02X%02X", tracks[0].Number, tracks[len(tracks)-1].Number))
+
+	for _, offset := range offsets {
+		sb.WriteString(fmt.Sprintf("%08X", offset))
+	}
+
+	sum := sha1.Sum([]byte(sb.String()))
+
+	return strings.NewReplacer("+", ".", "/", "_", "=", "-").Replace(base64.StdEncoding.EncodeToString(sum[:]))
+}
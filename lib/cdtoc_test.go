@@ -0,0 +1,92 @@
+package lib
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+)
+
+// testTOC will return binary table of contents of track offsets and lead-out, offsets include pregap like disc id
+// documentation. Tracks after audio tracks are data tracks.
+func testTOC(offsets []int, leadOut int, audioTracks int) []byte {
+	data := []byte{0, 0, 1, byte(len(offsets))}
+
+	descriptor := func(control byte, number int, offset int) {
+		d := make([]byte, tocDescriptorSize)
+		d[1] = control
+		d[2] = byte(number)
+		binary.BigEndian.PutUint32(d[4:], uint32(offset-PregapSectors))
+		data = append(data, d...)
+	}
+
+	for i, offset := range offsets {
+		control := byte(0x10)
+		if i >= audioTracks {
+			control |= tocDataTrackFlag
+		}
+
+		descriptor(control, i+1, offset)
+	}
+
+	descriptor(0x10, tocLeadOutTrack, leadOut)
+
+	return data
+}
+
+// musicBrainzOffsets are offsets of the example of MusicBrainz disc id documentation.
+var musicBrainzOffsets = []int{
+	150, 22767, 41887, 58317, 72102, 91375, 104652, 115380, 132165, 143932, 159870, 174597,
+}
+
+func TestTOCDiscIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        []byte
+		freeDB      string
+		musicBrainz string
+	}{
+		{
+			name:        "audio",
+			data:        testTOC(musicBrainzOffsets, 267257, len(musicBrainzOffsets)),
+			freeDB:      "a70de90c",
+			musicBrainz: "I5l9cCSFccLKFEKS.7wqSZAorPU-",
+		},
+		{
+			name:        "enhanced",
+			data:        testTOC(append(musicBrainzOffsets, 267257+DataTrackGapSectors), 300000, len(musicBrainzOffsets)),
+			freeDB:      "b70f9e0d",
+			musicBrainz: "I5l9cCSFccLKFEKS.7wqSZAorPU-",
+		},
+	}
+
+	for _, tt := range tests {
+		toc, err := ParseTOC(tt.data)
+		if err != nil {
+			t.Fatalf("ParseTOC(%s) error = %v", tt.name, err)
+		}
+
+		if got := toc.FreeDBID(); got != tt.freeDB {
+			t.Errorf("FreeDBID(%s) = %q, want %q", tt.name, got, tt.freeDB)
+		}
+
+		if got := toc.MusicBrainzDiscID(); got != tt.musicBrainz {
+			t.Errorf("MusicBrainzDiscID(%s) = %q, want %q", tt.name, got, tt.musicBrainz)
+		}
+	}
+}
+
+func TestParseTOCInvalid(t *testing.T) {
+	tests := [][]byte{
+		nil,
+		{0, 0, 1},
+		{0, 0, 1, 1, 0, 0x10, 1},
+		{0, 0, 1, 1, 0, 0x10, 1, 0, 0, 0, 0, 0},
+		{0, 0, 1, 1, 0, 0x10, tocLeadOutTrack, 0, 0, 0, 1, 0},
+	}
+
+	for _, data := range tests {
+		if _, err := ParseTOC(data); !errors.Is(err, ErrInvalidTOC) {
+			t.Errorf("ParseTOC(%v) error = %v, want %v", data, err, ErrInvalidTOC)
+		}
+	}
+}
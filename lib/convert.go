@@ -1,5 +1,13 @@
 package lib
 
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"time"
+)
+
 func ByteToInt(b []byte) int {
 	size := 0
 	for i := range b {
@@ -8,3 +16,65 @@ func ByteToInt(b []byte) int {
 
 	return size
 }
+
+// MPEGFrameDuration will return duration of a MPEG frame, like 1152 samples per frame at 44100 Hz for MPEG-1 Layer III.
+func MPEGFrameDuration(samplesPerFrame, sampleRate int) time.Duration {
+	if sampleRate == 0 {
+		return 0
+	}
+
+	return time.Duration(samplesPerFrame) * time.Second / time.Duration(sampleRate)
+}
+
+// SyncsafeToInt will convert synchsafe integer, which has 7 significant bits in each byte, to int.
+func SyncsafeToInt(b []byte) int {
+	size := 0
+	for i := range b {
+		size = size<<7 + int(b[i]&0x7f)
+	}
+
+	return size
+}
+
+// IntToByte will convert n to big-endian bytes of the given size.
+func IntToByte(n, size int) []byte {
+	b := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		b[i] = byte(n)
+		n >>= 8
+	}
+
+	return b
+}
+
+// Deunsynchronise will reverse unsynchronisation scheme, which inserts $00 after every $FF.
+func Deunsynchronise(data []byte) []byte {
+	res := make([]byte, 0, len(data))
+
+	for i := 0; i < len(data); i++ {
+		res = append(res, data[i])
+
+		if data[i] == 0xff && i+1 < len(data) && data[i+1] == 0 {
+			i++
+		}
+	}
+
+	return res
+}
+
+// Inflate will decompress zlib compressed data.
+func Inflate(data []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error on new zlib reader: %w", err)
+	}
+
+	defer func() { _ = r.Close() }()
+
+	res, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error on inflate: %w", err)
+	}
+
+	return res, nil
+}
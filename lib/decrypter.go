@@ -0,0 +1,14 @@
+package lib
+
+// Decrypter decrypts body of frames encrypted with an owner specific method.
+// Owner identifier and encryption data come from the encryption method registration frame of the method.
+type Decrypter interface {
+	Decrypt(ownerIdentifier string, encryptionData []byte, data []byte) ([]byte, error)
+}
+
+// DecrypterFunc is an adapter to use ordinary functions as Decrypter.
+type DecrypterFunc func(ownerIdentifier string, encryptionData []byte, data []byte) ([]byte, error)
+
+func (f DecrypterFunc) Decrypt(ownerIdentifier string, encryptionData []byte, data []byte) ([]byte, error) {
+	return f(ownerIdentifier, encryptionData, data)
+}
@@ -3,7 +3,6 @@ package lib
 import (
 	"bytes"
 	"unicode/utf16"
-	"unicode/utf8"
 )
 
 type Encoding struct {
@@ -18,6 +17,11 @@ var Encodings = []Encoding{
 	{"UTF-8", 1},
 }
 
+// IsValidEncoding reports whether b is a text encoding byte of Encodings.
+func IsValidEncoding(b byte) bool {
+	return int(b) < len(Encodings)
+}
+
 func ToUTF8(data []byte, enc Encoding) string {
 	switch enc.Title {
 	case "ISO-8859-1":
@@ -27,12 +31,8 @@ func ToUTF8(data []byte, enc Encoding) string {
 		}
 
 		return string(buf)
-	case "UTF-16":
-		u16s := make([]uint16, 1)
-
-		ret := &bytes.Buffer{}
-
-		b8buf := make([]byte, 4)
+	case "UTF-16", "UTF-16BE":
+		bigEndian := enc.Title == "UTF-16BE"
 
 		lb := len(data)
 		i := 0
@@ -43,16 +43,119 @@ func ToUTF8(data []byte, enc Encoding) string {
 			lb--
 		}
 
+		// Byte order mark
+		if i+1 < len(data) {
+			switch {
+			case data[i] == 0xff && data[i+1] == 0xfe:
+				bigEndian = false
+				i += 2
+			case data[i] == 0xfe && data[i+1] == 0xff:
+				bigEndian = true
+				i += 2
+			}
+		}
+
+		u16s := make([]uint16, 0, len(data)/2)
+
 		for ; i < lb; i += 2 {
-			u16s[0] = uint16(data[i]) + (uint16(data[i+1]) << 8)
-			r := utf16.Decode(u16s)
-			n := utf8.EncodeRune(b8buf, r[0])
-			ret.Write(b8buf[:n])
+			if bigEndian {
+				u16s = append(u16s, uint16(data[i])<<8+uint16(data[i+1]))
+			} else {
+				u16s = append(u16s, uint16(data[i])+uint16(data[i+1])<<8)
+			}
 		}
 
-		return ret.String()
+		return string(utf16.Decode(u16s))
 		// TODO: check other encodings
 	default:
 		return string(data)
 	}
 }
+
+// FromUTF8 will encode s with the given encoding, UTF-16 is encoded little endian with byte order mark.
+// Characters which ISO-8859-1 can not encode are replaced by "?".
+func FromUTF8(s string, enc Encoding) []byte {
+	switch enc.Title {
+	case "ISO-8859-1":
+		res := make([]byte, 0, len(s))
+
+		for _, r := range s {
+			if r > 0xff {
+				r = '?'
+			}
+
+			res = append(res, byte(r))
+		}
+
+		return res
+	case "UTF-16", "UTF-16BE":
+		u16s := utf16.Encode([]rune(s))
+		res := make([]byte, 0, 2+2*len(u16s))
+
+		if enc.Title == "UTF-16" {
+			res = append(res, 0xff, 0xfe)
+
+			for _, u := range u16s {
+				res = append(res, byte(u), byte(u>>8))
+			}
+
+			return res
+		}
+
+		for _, u := range u16s {
+			res = append(res, byte(u>>8), byte(u))
+		}
+
+		return res
+	default:
+		return []byte(s)
+	}
+}
+
+// Byte will return text encoding byte of encoding, which is its index in Encodings.
+func (enc Encoding) Byte() byte {
+	for i := range Encodings {
+		if Encodings[i] == enc {
+			return byte(i)
+		}
+	}
+
+	return 0
+}
+
+// Termination will return string termination of encoding.
+func (enc Encoding) Termination() []byte {
+	return make([]byte, enc.Size)
+}
+
+// SplitTerminated splits data on the first string termination of the given encoding.
+// If there is no termination, whole data is returned as the first value.
+func SplitTerminated(data []byte, enc Encoding) ([]byte, []byte) {
+	size := enc.Size
+	if size < 1 {
+		size = 1
+	}
+
+	for i := 0; i+size <= len(data); i += size {
+		if bytes.Equal(data[i:i+size], make([]byte, size)) {
+			return data[:i], data[i+size:]
+		}
+	}
+
+	return data, nil
+}
+
+// SplitStrings splits data into terminated strings of the given encoding and converts them to UTF-8.
+// Termination of the last string is optional.
+func SplitStrings(data []byte, enc Encoding) []string {
+	res := make([]string, 0)
+
+	for len(data) > 0 {
+		var s []byte
+
+		s, data = SplitTerminated(data, enc)
+		res = append(res, ToUTF8(s, enc))
+	}
+
+	return res
+}
@@ -0,0 +1,50 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// WriteFile writes data into a new file named name inside dir and returns its path.
+// Directory parts of name are dropped, so a tag can not write outside of dir. Existing files are not
+// overwritten, a number is added to name instead, like "cover (1).png" when "cover.png" exists.
+func WriteFile(dir, name string, data []byte) (string, error) {
+	name = filepath.Base(filepath.Clean("/" + strings.ReplaceAll(name, "\\", "/")))
+	if name == "/" || name == "." {
+		name = "object"
+	}
+
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+
+	for i := 0; ; i++ {
+		path := filepath.Join(dir, name)
+		if i > 0 {
+			path = filepath.Join(dir, fmt.Sprintf("%s (%d)%s", base, i, ext))
+		}
+
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+
+		if err != nil {
+			return "", fmt.Errorf("error on create file: %w", err)
+		}
+
+		if _, err = f.Write(data); err != nil {
+			_ = f.Close()
+
+			return "", fmt.Errorf("error on write file: %w", err)
+		}
+
+		if err = f.Close(); err != nil {
+			return "", fmt.Errorf("error on close file: %w", err)
+		}
+
+		return path, nil
+	}
+}
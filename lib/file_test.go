@@ -0,0 +1,39 @@
+package lib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"notes.txt", "a", "notes.txt"},
+		{"notes.txt", "b", "notes (1).txt"},
+		{"../notes.txt", "c", "notes (2).txt"},
+		{"", "d", "object"},
+		{"README", "e", "README"},
+		{"README", "f", "README (1)"},
+	}
+
+	for _, tt := range tests {
+		path, err := WriteFile(dir, tt.name, []byte(tt.data))
+		if err != nil {
+			t.Fatalf("WriteFile(%q) error: %v", tt.name, err)
+		}
+
+		if want := filepath.Join(dir, tt.want); path != want {
+			t.Errorf("WriteFile(%q) = %q, want %q", tt.name, path, want)
+		}
+
+		if data, _ := os.ReadFile(path); string(data) != tt.data {
+			t.Errorf("WriteFile(%q) wrote %q, want %q", tt.name, data, tt.data)
+		}
+	}
+}
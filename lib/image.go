@@ -0,0 +1,122 @@
+package lib
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+
+	"golang.org/x/image/bmp"
+	"golang.org/x/image/webp"
+)
+
+// PictureLinkMIMEType is MIME type of attached pictures which data is URL of the picture.
+const PictureLinkMIMEType = "-->"
+
+// ErrPictureLink is returned on decoding attached picture which data is URL of the picture.
+var ErrPictureLink = errors.New("picture is a link")
+
+// imageDecoders are decoders of image MIME types.
+var imageDecoders = map[string]func(io.Reader) (image.Image, error){
+	"image/jpeg": jpeg.Decode,
+	"image/png":  png.Decode,
+	"image/gif":  gif.Decode,
+	"image/bmp":  bmp.Decode,
+	"image/webp": webp.Decode,
+}
+
+// imageSignatures are magic bytes of image formats, "?" matches any byte.
+var imageSignatures = []struct {
+	mimeType  string
+	signature string
+}{
+	{"image/jpeg", "\xff\xd8\xff"},
+	{"image/png", "\x89PNG\r\n\x1a\n"},
+	{"image/gif", "GIF87a"},
+	{"image/gif", "GIF89a"},
+	{"image/bmp", "BM"},
+	{"image/webp", "RIFF????WEBP"},
+	{"image/tiff", "II*\x00"},
+	{"image/tiff", "MM\x00*"},
+}
+
+// SniffImageMIMEType will return MIME type of image data by magic bytes, or an empty string for unknown formats.
+func SniffImageMIMEType(data []byte) string {
+	for _, s := range imageSignatures {
+		if len(data) < len(s.signature) {
+			continue
+		}
+
+		match := true
+
+		for i := range s.signature {
+			if s.signature[i] != '?' && s.signature[i] != data[i] {
+				match = false
+
+				break
+			}
+		}
+
+		if match {
+			return s.mimeType
+		}
+	}
+
+	return ""
+}
+
+// ImageMIMEType will return MIME type of picture MIME types and ID3v2.2 image formats,
+// like "image/jpeg" for "image/jpg", "JPG" and "jpeg".
+func ImageMIMEType(s string) string {
+	s = strings.ToLower(strings.TrimSpace(strings.TrimRight(s, "\x00")))
+	if s == "" || s == PictureLinkMIMEType {
+		return s
+	}
+
+	s = strings.TrimPrefix(s, "image/")
+	switch s {
+	case "jpg", "pjpeg":
+		s = "jpeg"
+	case "x-png":
+		s = "png"
+	case "x-ms-bmp", "x-bmp":
+		s = "bmp"
+	}
+
+	return "image/" + s
+}
+
+// DecodeImage will decode attached picture data, format is sniffed from magic bytes and mimeType is used when
+// data has an unknown signature. JPEG, PNG, GIF, BMP and WebP are supported, other formats are decoded when their
+// decoder is registered by image.RegisterFormat. Links, which MIME type is "-->", return ErrPictureLink.
+func DecodeImage(mimeType string, data []byte) (image.Image, error) {
+	if strings.TrimSpace(mimeType) == PictureLinkMIMEType {
+		return nil, fmt.Errorf("error on decode image of %q: %w", string(data), ErrPictureLink)
+	}
+
+	format := SniffImageMIMEType(data)
+	if format == "" {
+		format = ImageMIMEType(mimeType)
+	}
+
+	decode, ok := imageDecoders[format]
+	if !ok {
+		decode = func(r io.Reader) (image.Image, error) {
+			res, _, err := image.Decode(r)
+
+			return res, err
+		}
+	}
+
+	res, err := decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error on decode %s: %w", strings.TrimPrefix(format, "image/"), err)
+	}
+
+	return res, nil
+}
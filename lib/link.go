@@ -0,0 +1,37 @@
+package lib
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LinkResolver opens files which linked information frames refer to by url.
+type LinkResolver interface {
+	Open(url string) (io.ReadSeekCloser, error)
+}
+
+// LinkResolverFunc is an adapter to use ordinary functions as LinkResolver.
+type LinkResolverFunc func(url string) (io.ReadSeekCloser, error)
+
+func (f LinkResolverFunc) Open(url string) (io.ReadSeekCloser, error) {
+	return f(url)
+}
+
+// DirLinkResolver resolves urls as paths inside a local directory, like shared album art of a directory.
+// A "file://" scheme is dropped and paths can not point outside of the directory.
+type DirLinkResolver string
+
+func (d DirLinkResolver) Open(url string) (io.ReadSeekCloser, error) {
+	name := path.Clean("/" + strings.TrimPrefix(url, "file://"))
+
+	f, err := os.Open(filepath.Join(string(d), filepath.FromSlash(name)))
+	if err != nil {
+		return nil, fmt.Errorf("error on open linked file: %w", err)
+	}
+
+	return f, nil
+}
@@ -0,0 +1,44 @@
+package lib
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseNumberAndTotal will parse number and total of values like "1/2", "01", "1 of 2", "1 / 2" and "3/".
+// Trailing junk after digits is ignored, like "3 (bonus)", ok is false when there is no number.
+// Total is zero when it is missing or not a number.
+func ParseNumberAndTotal(s string) (number, total int, ok bool) {
+	s = strings.ToLower(strings.TrimSpace(strings.TrimRight(s, "\x00")))
+	s = strings.ReplaceAll(s, " of ", "/")
+	parts := strings.SplitN(s, "/", 2)
+
+	number, ok = leadingNumber(parts[0])
+	if !ok {
+		return 0, 0, false
+	}
+
+	if len(parts) > 1 {
+		total, _ = leadingNumber(parts[1])
+	}
+
+	return number, total, true
+}
+
+// leadingNumber will parse digits at the start of s, leading spaces and zeros are allowed.
+func leadingNumber(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+
+	end := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) || r > unicode.MaxASCII })
+	if end < 0 {
+		end = len(s)
+	}
+
+	n, err := strconv.Atoi(s[:end])
+	if err != nil {
+		return 0, false
+	}
+
+	return n, true
+}
@@ -0,0 +1,40 @@
+package lib
+
+import "testing"
+
+func TestParseNumberAndTotal(t *testing.T) {
+	tests := []struct {
+		s      string
+		number int
+		total  int
+		ok     bool
+	}{
+		{"3", 3, 0, true},
+		{"03", 3, 0, true},
+		{" 003 ", 3, 0, true},
+		{"3/12", 3, 12, true},
+		{"03/012", 3, 12, true},
+		{"3 / 12", 3, 12, true},
+		{"3 of 12", 3, 12, true},
+		{"3 OF 12", 3, 12, true},
+		{"3/", 3, 0, true},
+		{"3\x00", 3, 0, true},
+		{"3 (bonus)", 3, 0, true},
+		{"3/x", 3, 0, true},
+		{"/12", 0, 0, false},
+		{"A1", 0, 0, false},
+		{"abc", 0, 0, false},
+		{"", 0, 0, false},
+		{"99999999999999999999", 0, 0, false},
+		{"3/99999999999999999999", 3, 0, true},
+		{"0", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		number, total, ok := ParseNumberAndTotal(tt.s)
+		if number != tt.number || total != tt.total || ok != tt.ok {
+			t.Errorf("ParseNumberAndTotal(%q) = %d, %d, %v, want %d, %d, %v",
+				tt.s, number, total, ok, tt.number, tt.total, tt.ok)
+		}
+	}
+}
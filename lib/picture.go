@@ -0,0 +1,38 @@
+package lib
+
+import "strconv"
+
+// PictureType is type of attached picture, like front cover or artist.
+type PictureType int
+
+var pictureTypeNames = []string{
+	"Other",
+	"32x32 pixels file icon",
+	"Other file icon",
+	"Cover (front)",
+	"Cover (back)",
+	"Leaflet page",
+	"Media",
+	"Lead artist",
+	"Artist",
+	"Conductor",
+	"Band/Orchestra",
+	"Composer",
+	"Lyricist/text writer",
+	"Recording location",
+	"During recording",
+	"During performance",
+	"Movie/video screen capture",
+	"A bright coloured fish",
+	"Illustration",
+	"Band/artist logotype",
+	"Publisher/Studio logotype",
+}
+
+func (t PictureType) String() string {
+	if t >= 0 && int(t) < len(pictureTypeNames) {
+		return pictureTypeNames[t]
+	}
+
+	return "Unknown (" + strconv.Itoa(int(t)) + ")"
+}
@@ -0,0 +1,35 @@
+package lib
+
+import (
+	"strings"
+)
+
+// Price is a price in a currency, like "USD" and "1.99".
+type Price struct {
+	// Currency is ISO 4217 currency code.
+	Currency string
+	Amount   string
+}
+
+func (p Price) String() string {
+	return p.Currency + p.Amount
+}
+
+// ParsePrices will parse prices like "USD1.99/EUR1.50", which each price is a currency code followed by amount.
+func ParsePrices(s string) []Price {
+	prices := make([]Price, 0)
+
+	for _, price := range strings.Split(s, "/") {
+		price = strings.TrimSpace(price)
+		if len(price) < 3 {
+			continue
+		}
+
+		prices = append(prices, Price{
+			Currency: price[:3],
+			Amount:   price[3:],
+		})
+	}
+
+	return prices
+}
@@ -0,0 +1,128 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Precision is the most precise part of a timestamp which is known.
+type Precision int
+
+const (
+	PrecisionNone Precision = iota
+	PrecisionYear
+	PrecisionMonth
+	PrecisionDay
+	PrecisionHour
+	PrecisionMinute
+	PrecisionSecond
+)
+
+var ErrInvalidTimestamp = errors.New("invalid timestamp")
+
+// timestampLayouts are layouts of ID3v2.4 subset of ISO 8601, indexed by precision.
+var timestampLayouts = []string{
+	PrecisionYear:   "2006",
+	PrecisionMonth:  "2006-01",
+	PrecisionDay:    "2006-01-02",
+	PrecisionHour:   "2006-01-02T15",
+	PrecisionMinute: "2006-01-02T15:04",
+	PrecisionSecond: "2006-01-02T15:04:05",
+}
+
+// Timestamp is a time with precision, like "2004" which is only known to the year.
+// Parts of Time after precision are zero.
+type Timestamp struct {
+	Time      time.Time
+	Precision Precision
+}
+
+// ParseTimestamp will parse timestamps like "yyyy", "yyyy-MM", "yyyy-MM-dd", "yyyy-MM-ddTHH", "yyyy-MM-ddTHH:mm"
+// and "yyyy-MM-ddTHH:mm:ss". A space is accepted instead of "T".
+func ParseTimestamp(s string) (Timestamp, error) {
+	s = strings.TrimSpace(strings.TrimRight(s, "\x00"))
+	if len(s) > 10 && s[10] == ' ' {
+		s = s[:10] + "T" + s[11:]
+	}
+
+	for precision := PrecisionYear; precision <= PrecisionSecond; precision++ {
+		if len(s) != len(timestampLayouts[precision]) {
+			continue
+		}
+
+		t, err := time.Parse(timestampLayouts[precision], s)
+		if err != nil {
+			break
+		}
+
+		return Timestamp{Time: t, Precision: precision}, nil
+	}
+
+	return Timestamp{}, fmt.Errorf("%w: '%s'", ErrInvalidTimestamp, s)
+}
+
+// ParseSplitTimestamp will parse ID3v2.3 year, date and time, like "2004", "3112" (DDMM) and "2359" (HHMM).
+// Date and time are optional, time is ignored without date. An invalid date or time is ignored and the
+// timestamp is as precise as the valid parts, only an invalid year is an error.
+func ParseSplitTimestamp(year, date, hourMinute string) (Timestamp, error) {
+	year = strings.TrimSpace(strings.TrimRight(year, "\x00"))
+	date = strings.TrimSpace(strings.TrimRight(date, "\x00"))
+	hourMinute = strings.TrimSpace(strings.TrimRight(hourMinute, "\x00"))
+
+	candidates := []string{year}
+
+	if len(date) == 4 {
+		day := year + "-" + date[2:4] + "-" + date[0:2]
+		candidates = append([]string{day}, candidates...)
+
+		if len(hourMinute) == 4 {
+			candidates = append([]string{day + "T" + hourMinute[0:2] + ":" + hourMinute[2:4]}, candidates...)
+		}
+	}
+
+	var err error
+
+	for _, candidate := range candidates {
+		var ts Timestamp
+		if ts, err = ParseTimestamp(candidate); err == nil {
+			return ts, nil
+		}
+	}
+
+	return Timestamp{}, err
+}
+
+// SplitTimestamp will split timestamp into ID3v2.3 year, date (DDMM) and time (HHMM), the inverse of
+// ParseSplitTimestamp. Date and time are empty when timestamp is less precise than a day and a minute.
+func SplitTimestamp(t Timestamp) (year, date, hourMinute string) {
+	if t.IsZero() {
+		return "", "", ""
+	}
+
+	year = t.Time.Format("2006")
+
+	if t.Precision >= PrecisionDay {
+		date = t.Time.Format("0201")
+	}
+
+	if t.Precision >= PrecisionMinute {
+		hourMinute = t.Time.Format("1504")
+	}
+
+	return year, date, hourMinute
+}
+
+func (t Timestamp) IsZero() bool {
+	return t.Precision == PrecisionNone
+}
+
+// String will format timestamp up to its precision.
+func (t Timestamp) String() string {
+	if t.Precision <= PrecisionNone || int(t.Precision) >= len(timestampLayouts) {
+		return ""
+	}
+
+	return t.Time.Format(timestampLayouts[t.Precision])
+}
@@ -0,0 +1,123 @@
+package lib
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func utc(year int, month time.Month, day, hour, minute, second int) time.Time {
+	return time.Date(year, month, day, hour, minute, second, 0, time.UTC)
+}
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Timestamp
+		err  error
+	}{
+		{"2004", Timestamp{utc(2004, 1, 1, 0, 0, 0), PrecisionYear}, nil},
+		{"2004-06", Timestamp{utc(2004, 6, 1, 0, 0, 0), PrecisionMonth}, nil},
+		{"2004-06-15", Timestamp{utc(2004, 6, 15, 0, 0, 0), PrecisionDay}, nil},
+		{"2004-06-15T13", Timestamp{utc(2004, 6, 15, 13, 0, 0), PrecisionHour}, nil},
+		{"2004-06-15T13:45", Timestamp{utc(2004, 6, 15, 13, 45, 0), PrecisionMinute}, nil},
+		{"2004-06-15T13:45:30", Timestamp{utc(2004, 6, 15, 13, 45, 30), PrecisionSecond}, nil},
+		{"2004-06-15 13:45:30", Timestamp{utc(2004, 6, 15, 13, 45, 30), PrecisionSecond}, nil},
+		{" 2004\x00", Timestamp{utc(2004, 1, 1, 0, 0, 0), PrecisionYear}, nil},
+		{"", Timestamp{}, ErrInvalidTimestamp},
+		{"04", Timestamp{}, ErrInvalidTimestamp},
+		{"2004-13", Timestamp{}, ErrInvalidTimestamp},
+		{"2004-02-30", Timestamp{}, ErrInvalidTimestamp},
+		{"2004-06-15T25:00", Timestamp{}, ErrInvalidTimestamp},
+		{"summer 2004", Timestamp{}, ErrInvalidTimestamp},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTimestamp(tt.s)
+		if !errors.Is(err, tt.err) || !got.Time.Equal(tt.want.Time) || got.Precision != tt.want.Precision {
+			t.Errorf("ParseTimestamp(%q) = %v, %v, want %v, %v", tt.s, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseSplitTimestamp(t *testing.T) {
+	tests := []struct {
+		year, date, hourMinute string
+		want                   string
+		err                    error
+	}{
+		{"2004", "", "", "2004", nil},
+		{"2004", "1506", "", "2004-06-15", nil},
+		{"2004", "1506", "1345", "2004-06-15T13:45", nil},
+		{"2004", "", "1345", "2004", nil},
+		{"2004\x00", "1506\x00", "1345\x00", "2004-06-15T13:45", nil},
+		{"2004", "1506", "2561", "2004-06-15", nil},
+		{"2004", "3102", "1345", "2004", nil},
+		{"2004", "15", "", "2004", nil},
+		{"", "1506", "1345", "", ErrInvalidTimestamp},
+		{"04", "", "", "", ErrInvalidTimestamp},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseSplitTimestamp(tt.year, tt.date, tt.hourMinute)
+		if !errors.Is(err, tt.err) || got.String() != tt.want {
+			t.Errorf("ParseSplitTimestamp(%q, %q, %q) = %q, %v, want %q, %v",
+				tt.year, tt.date, tt.hourMinute, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestSplitTimestamp(t *testing.T) {
+	tests := []struct {
+		ts                     Timestamp
+		year, date, hourMinute string
+	}{
+		{Timestamp{}, "", "", ""},
+		{Timestamp{utc(2004, 1, 1, 0, 0, 0), PrecisionYear}, "2004", "", ""},
+		{Timestamp{utc(2004, 6, 1, 0, 0, 0), PrecisionMonth}, "2004", "", ""},
+		{Timestamp{utc(2004, 6, 15, 0, 0, 0), PrecisionDay}, "2004", "1506", ""},
+		{Timestamp{utc(2004, 6, 15, 13, 0, 0), PrecisionHour}, "2004", "1506", ""},
+		{Timestamp{utc(2004, 6, 15, 13, 45, 0), PrecisionMinute}, "2004", "1506", "1345"},
+		{Timestamp{utc(2004, 6, 15, 13, 45, 30), PrecisionSecond}, "2004", "1506", "1345"},
+	}
+
+	for _, tt := range tests {
+		year, date, hourMinute := SplitTimestamp(tt.ts)
+		if year != tt.year || date != tt.date || hourMinute != tt.hourMinute {
+			t.Errorf("SplitTimestamp(%v) = %q, %q, %q, want %q, %q, %q",
+				tt.ts, year, date, hourMinute, tt.year, tt.date, tt.hourMinute)
+		}
+	}
+}
+
+func TestTimestampString(t *testing.T) {
+	tests := []struct {
+		ts   Timestamp
+		want string
+	}{
+		{Timestamp{}, ""},
+		{Timestamp{utc(2004, 6, 15, 13, 45, 30), PrecisionNone}, ""},
+		{Timestamp{utc(2004, 1, 1, 0, 0, 0), PrecisionYear}, "2004"},
+		{Timestamp{utc(2004, 6, 1, 0, 0, 0), PrecisionMonth}, "2004-06"},
+		{Timestamp{utc(2004, 6, 15, 0, 0, 0), PrecisionDay}, "2004-06-15"},
+		{Timestamp{utc(2004, 6, 15, 13, 0, 0), PrecisionHour}, "2004-06-15T13"},
+		{Timestamp{utc(2004, 6, 15, 13, 45, 0), PrecisionMinute}, "2004-06-15T13:45"},
+		{Timestamp{utc(2004, 6, 15, 13, 45, 30), PrecisionSecond}, "2004-06-15T13:45:30"},
+		{Timestamp{utc(2004, 6, 15, 13, 45, 30), PrecisionSecond + 1}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.ts.String(); got != tt.want {
+			t.Errorf("Timestamp{%v, %d}.String() = %q, want %q", tt.ts.Time, tt.ts.Precision, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimestampString(t *testing.T) {
+	for _, s := range []string{"2004", "2004-06", "2004-06-15", "2004-06-15T13", "2004-06-15T13:45", "2004-06-15T13:45:30"} {
+		ts, err := ParseTimestamp(s)
+		if err != nil || ts.String() != s {
+			t.Errorf("ParseTimestamp(%q).String() = %q, %v, want %q", s, ts, err, s)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package id3
+
+import (
+	"strings"
+)
+
+// MusicBrainzOwnerIdentifier is owner identifier of unique file identifier written by MusicBrainz Picard.
+const MusicBrainzOwnerIdentifier = "http://musicbrainz.org"
+
+// MusicBrainzIDs are MusicBrainz identifiers of a file, as written by MusicBrainz Picard.
+type MusicBrainzIDs struct {
+	RecordingID    string
+	TrackID        string
+	AlbumID        string
+	ArtistIDs      []string
+	AlbumArtistIDs []string
+	ReleaseGroupID string
+	WorkID         string
+}
+
+func (t ID3) uniqueFileIdentifier(owner string) string {
+	if t.V24 != nil {
+		if id := t.V24.UniqueFileIdentifier(owner); len(id) > 0 {
+			return string(id)
+		}
+	}
+
+	if t.V23 != nil {
+		if id := t.V23.UniqueFileIdentifier(owner); len(id) > 0 {
+			return string(id)
+		}
+	}
+
+	if t.V22 != nil {
+		if id := t.V22.UniqueFileIdentifier(owner); len(id) > 0 {
+			return string(id)
+		}
+	}
+
+	return ""
+}
+
+func (t ID3) userDefinedTextInformation(description string) string {
+	if t.V24 != nil {
+		if value := trimNull(t.V24.UserDefinedTextInformation(description)); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := trimNull(t.V23.UserDefinedTextInformation(description)); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := trimNull(t.V22.UserDefinedTextInformation(description)); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+func trimNull(s string) string {
+	return strings.TrimRight(s, "\x00")
+}
+
+// splitIDs splits identifiers separated by null (v2.4) or slash (v2.3).
+func splitIDs(s string) []string {
+	ids := make([]string, 0)
+
+	for _, id := range strings.FieldsFunc(s, func(r rune) bool { return r == 0 || r == '/' }) {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
+
+// MusicBrainzIDs will return MusicBrainz identifiers from UFID and TXXX frames.
+func (t ID3) MusicBrainzIDs() MusicBrainzIDs {
+	return MusicBrainzIDs{
+		RecordingID:    trimNull(t.uniqueFileIdentifier(MusicBrainzOwnerIdentifier)),
+		TrackID:        t.userDefinedTextInformation("MusicBrainz Release Track Id"),
+		AlbumID:        t.userDefinedTextInformation("MusicBrainz Album Id"),
+		ArtistIDs:      splitIDs(t.userDefinedTextInformation("MusicBrainz Artist Id")),
+		AlbumArtistIDs: splitIDs(t.userDefinedTextInformation("MusicBrainz Album Artist Id")),
+		ReleaseGroupID: t.userDefinedTextInformation("MusicBrainz Release Group Id"),
+		WorkID:         t.userDefinedTextInformation("MusicBrainz Work Id"),
+	}
+}
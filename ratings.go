@@ -0,0 +1,91 @@
+package id3
+
+// Well known popularimeter emails.
+const (
+	EmailWindowsMediaPlayer = "Windows Media Player 9 Series"
+	EmailMediaMonkey        = "no@email"
+)
+
+// Rating is popularimeter rating of a user.
+type Rating struct {
+	Email string
+	// Rating is raw popularimeter rating, 1 is worst and 255 is best, 0 is unknown.
+	Rating uint8
+	// Stars is rating in 0 to 5 scale, 0 is unknown.
+	Stars   float64
+	Counter int
+}
+
+type popularimeter interface {
+	EmailToUser() string
+	Rating() uint8
+	Counter() int
+}
+
+type starRange struct {
+	max   uint8
+	stars float64
+}
+
+// Windows Media Player writes 1, 64, 128, 196 and 255 for 1 to 5 stars.
+var windowsMediaPlayerStars = []starRange{
+	{0, 0},
+	{31, 1},
+	{95, 2},
+	{159, 3},
+	{223, 4},
+	{255, 5},
+}
+
+// MediaMonkey writes half stars too, 13 for 0.5 and 54, 118, 186, 242 for the rest.
+var mediaMonkeyStars = []starRange{
+	{0, 0},
+	{1, 1},
+	{19, 0.5},
+	{49, 1},
+	{59, 1.5},
+	{113, 2},
+	{123, 2.5},
+	{185, 3},
+	{195, 3.5},
+	{241, 4},
+	{251, 4.5},
+	{255, 5},
+}
+
+// Stars will convert popularimeter rating to 0 to 5 stars scale, based on the convention of the player owning email.
+func Stars(email string, rating uint8) float64 {
+	var ranges []starRange
+
+	switch email {
+	case EmailWindowsMediaPlayer:
+		ranges = windowsMediaPlayerStars
+	case EmailMediaMonkey:
+		ranges = mediaMonkeyStars
+	default:
+		return float64(rating) * 5 / 255
+	}
+
+	for _, r := range ranges {
+		if rating <= r.max {
+			return r.stars
+		}
+	}
+
+	return 5
+}
+
+func newRatings(popularimeters []popularimeter) []Rating {
+	ratings := make([]Rating, len(popularimeters))
+
+	for i, p := range popularimeters {
+		ratings[i] = Rating{
+			Email:   p.EmailToUser(),
+			Rating:  p.Rating(),
+			Stars:   Stars(p.EmailToUser(), p.Rating()),
+			Counter: p.Counter(),
+		}
+	}
+
+	return ratings
+}
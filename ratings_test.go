@@ -0,0 +1,69 @@
+package id3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStars(t *testing.T) {
+	tests := []struct {
+		email  string
+		rating uint8
+		want   float64
+	}{
+		{EmailWindowsMediaPlayer, 0, 0},
+		{EmailWindowsMediaPlayer, 1, 1},
+		{EmailWindowsMediaPlayer, 31, 1},
+		{EmailWindowsMediaPlayer, 32, 2},
+		{EmailWindowsMediaPlayer, 64, 2},
+		{EmailWindowsMediaPlayer, 128, 3},
+		{EmailWindowsMediaPlayer, 196, 4},
+		{EmailWindowsMediaPlayer, 255, 5},
+		{EmailMediaMonkey, 0, 0},
+		{EmailMediaMonkey, 1, 1},
+		{EmailMediaMonkey, 13, 0.5},
+		{EmailMediaMonkey, 54, 1.5},
+		{EmailMediaMonkey, 64, 2},
+		{EmailMediaMonkey, 118, 2.5},
+		{EmailMediaMonkey, 128, 3},
+		{EmailMediaMonkey, 186, 3.5},
+		{EmailMediaMonkey, 196, 4},
+		{EmailMediaMonkey, 242, 4.5},
+		{EmailMediaMonkey, 255, 5},
+		{"", 0, 0},
+		{"", 51, 1},
+		{"user@example.com", 153, 3},
+		{"user@example.com", 255, 5},
+	}
+
+	for _, tt := range tests {
+		if got := Stars(tt.email, tt.rating); got != tt.want {
+			t.Errorf("Stars(%q, %d) = %v, want %v", tt.email, tt.rating, got, tt.want)
+		}
+	}
+}
+
+type testPopularimeter struct {
+	email   string
+	rating  uint8
+	counter int
+}
+
+func (p testPopularimeter) EmailToUser() string { return p.email }
+func (p testPopularimeter) Rating() uint8       { return p.rating }
+func (p testPopularimeter) Counter() int        { return p.counter }
+
+func TestNewRatings(t *testing.T) {
+	got := newRatings([]popularimeter{
+		testPopularimeter{EmailWindowsMediaPlayer, 196, 3},
+		testPopularimeter{"user@example.com", 102, 0},
+	})
+	want := []Rating{
+		{Email: EmailWindowsMediaPlayer, Rating: 196, Stars: 4, Counter: 3},
+		{Email: "user@example.com", Rating: 102, Stars: 2, Counter: 0},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newRatings() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,189 @@
+package id3
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// SortArticles are leading articles of languages by ISO 639-2 code, which generated sort keys move to the end.
+var SortArticles = map[string][]string{
+	"eng": {"The ", "A ", "An "},
+	"fre": {"Les ", "Le ", "La ", "L'", "Une ", "Un "},
+	"fra": {"Les ", "Le ", "La ", "L'", "Une ", "Un "},
+	"ger": {"Der ", "Die ", "Das ", "Eine ", "Ein "},
+	"deu": {"Der ", "Die ", "Das ", "Eine ", "Ein "},
+	"spa": {"Los ", "Las ", "El ", "La ", "Una ", "Un "},
+	"ita": {"Gli ", "Il ", "Lo ", "La ", "Le ", "I ", "L'", "Una ", "Uno ", "Un "},
+	"dut": {"De ", "Het ", "Een "},
+	"nld": {"De ", "Het ", "Een "},
+	"por": {"Os ", "As ", "O ", "A ", "Uma ", "Um "},
+}
+
+// SortKey will generate sort key of s, like "Beatles, The" for "The Beatles".
+// Leading punctuation is dropped and leading article of language is moved to the end,
+// English articles are used for languages without articles in SortArticles.
+func SortKey(s, language string) string {
+	s = strings.TrimLeftFunc(strings.TrimSpace(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	articles, ok := SortArticles[strings.ToLower(language)]
+	if !ok {
+		articles = SortArticles["eng"]
+	}
+
+	for _, article := range articles {
+		if len(s) > len(article) && strings.EqualFold(s[:len(article)], article) {
+			return s[len(article):] + ", " + strings.TrimSpace(s[:len(article)])
+		}
+	}
+
+	return s
+}
+
+// LessSortKey reports whether sort key a sorts before sort key b by collation of lang, an ISO 639-2 code like
+// "eng" or "swe", ignoring case. Unknown languages use the default collation, which sorts "Émile" before "Zed".
+func LessSortKey(a, b, lang string) bool {
+	tag, err := language.Parse(lang)
+	if err != nil {
+		tag = language.Und
+	}
+
+	if c := collate.New(tag, collate.IgnoreCase).CompareString(a, b); c != 0 {
+		return c < 0
+	}
+
+	return a < b
+}
+
+// language will return the first language of tag, which is used to generate sort keys.
+func (t ID3) language() string {
+	language := t.Language()
+	if len(language) < 3 {
+		return ""
+	}
+
+	return language[:3]
+}
+
+func (t ID3) SortTitle() string {
+	if t.V24 != nil {
+		if value := t.V24.TitleSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.TitleSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.TitleSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	return SortKey(t.Title(), t.language())
+}
+
+func (t ID3) SortArtist() string {
+	if t.V24 != nil {
+		if value := t.V24.ArtistSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.ArtistSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.ArtistSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if artists := t.Artists(); len(artists) > 0 {
+		return SortKey(artists[0], t.language())
+	}
+
+	return ""
+}
+
+func (t ID3) SortAlbum() string {
+	if t.V24 != nil {
+		if value := t.V24.AlbumSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.AlbumSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.AlbumSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	return SortKey(t.Album(), t.language())
+}
+
+// SortAlbumArtist will return album artist sort order, artist sort order is used when there is no album artist.
+func (t ID3) SortAlbumArtist() string {
+	if t.V24 != nil {
+		if value := t.V24.AlbumArtistSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.AlbumArtistSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.AlbumArtistSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if albumArtists := t.AlbumArtists(); len(albumArtists) > 0 {
+		return SortKey(albumArtists[0], t.language())
+	}
+
+	return t.SortArtist()
+}
+
+func (t ID3) SortComposer() string {
+	if t.V24 != nil {
+		if value := t.V24.ComposerSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V23 != nil {
+		if value := t.V23.ComposerSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	if t.V22 != nil {
+		if value := t.V22.ComposerSortOrder(); value != "" {
+			return value
+		}
+	}
+
+	return SortKey(t.Composer(), t.language())
+}
@@ -0,0 +1,45 @@
+package id3
+
+import "testing"
+
+func TestSortKey(t *testing.T) {
+	tests := []struct {
+		s, language, want string
+	}{
+		{"The Beatles", "eng", "Beatles, The"},
+		{"the beatles", "", "beatles, the"},
+		{"Les Misérables", "fre", "Misérables, Les"},
+		{"L'Amour", "fra", "Amour, L'"},
+		{"...And Justice", "eng", "And Justice"},
+		{"The", "eng", "The"},
+		{"Queen", "eng", "Queen"},
+	}
+
+	for _, tt := range tests {
+		if got := SortKey(tt.s, tt.language); got != tt.want {
+			t.Errorf("SortKey(%q, %q) = %q, want %q", tt.s, tt.language, got, tt.want)
+		}
+	}
+}
+
+func TestLessSortKey(t *testing.T) {
+	tests := []struct {
+		a, b, language string
+		want           bool
+	}{
+		{"Émile", "Zed", "eng", true},
+		{"Zed", "Émile", "eng", false},
+		{"abba", "Beatles", "eng", true},
+		{"Östen", "Zed", "eng", true},
+		{"Zed", "Östen", "swe", true},
+		{"Émile", "Zed", "XXX", true},
+		{"a", "A", "eng", false},
+		{"A", "a", "eng", true},
+	}
+
+	for _, tt := range tests {
+		if got := LessSortKey(tt.a, tt.b, tt.language); got != tt.want {
+			t.Errorf("LessSortKey(%q, %q, %q) = %v, want %v", tt.a, tt.b, tt.language, got, tt.want)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package v1
 
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
 // Genres is ID3v1 genre codes
 // https://de.wikipedia.org/wiki/Liste_der_ID3v1-Genres
 var Genres = []string{
@@ -207,3 +213,234 @@ var Genres = []string{
 	"Garage Rock",
 	"Psybient",
 }
+
+const (
+	// GenreRemix is genre of ID3v2 content type reference "RX".
+	GenreRemix = "Remix"
+	// GenreCover is genre of ID3v2 content type reference "CR".
+	GenreCover = "Cover"
+)
+
+// genreAliases are common names of genres which differ from Genres, keys are normalized by normalizeGenre.
+var genreAliases = map[string]int{
+	"rhythmandblues": 14,
+	"rnb":            14,
+	"psychedelic":    67,
+	"rockandroll":    78,
+	"rocknroll":      78,
+	"acappella":      123,
+	"drumandbass":    127,
+	"dnb":            127,
+	"jazzandfunk":    29,
+	"jazzfunk":       29,
+	"synthpop":       147,
+	"jpop":           146,
+	"nativeamerican": 64,
+}
+
+// GenreName will return name of genre id, or an empty string for unknown ids.
+func GenreName(id int) string {
+	if id >= 0 && id < len(Genres) {
+		return Genres[id]
+	}
+
+	return ""
+}
+
+// GenreID will return id of genre name. Names are compared ignoring case, spaces and punctuation, like "hiphop"
+// for "Hip-Hop", then common aliases and at last names with up to two typos are matched.
+func GenreID(name string) (int, bool) {
+	normalized := normalizeGenre(name)
+	if normalized == "" {
+		return 0, false
+	}
+
+	for id := range Genres {
+		if normalizeGenre(Genres[id]) == normalized {
+			return id, true
+		}
+	}
+
+	if id, ok := genreAliases[normalized]; ok {
+		return id, true
+	}
+
+	best, bestDistance := 0, 3
+	if len(normalized) < 5 {
+		bestDistance = 1
+	}
+
+	for id := range Genres {
+		if d := levenshtein(normalizeGenre(Genres[id]), normalized); d < bestDistance {
+			best, bestDistance = id, d
+		}
+	}
+
+	return best, bestDistance < 3 && (len(normalized) >= 5 || bestDistance < 1)
+}
+
+// normalizeGenre will lower case name and drop everything except letters and digits, "&" is read as "and".
+func normalizeGenre(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", "and")
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+
+		return -1
+	}, name)
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func min(values ...int) int {
+	res := values[0]
+
+	for _, v := range values[1:] {
+		if v < res {
+			res = v
+		}
+	}
+
+	return res
+}
+
+// genreReference will return genre of content type reference, like "17", "RX" or "CR".
+func genreReference(ref string) (string, bool) {
+	switch ref {
+	case "RX":
+		return GenreRemix, true
+	case "CR":
+		return GenreCover, true
+	}
+
+	id, err := strconv.Atoi(ref)
+	if err != nil || GenreName(id) == "" {
+		return "", false
+	}
+
+	return Genres[id], true
+}
+
+// ParseContentType will parse genres of ID3v2 content type, like "(17)(RX)", "(4)Eurodisco", "((Text)" or
+// ID3v2.4 null separated "17\x00RX\x00Rock". Refinement text after a reference is a genre of its own,
+// unless it equals the referenced genre.
+func ParseContentType(s string) []string {
+	genres := make([]string, 0)
+	add := func(genre string) {
+		if genre == "" {
+			return
+		}
+
+		for _, g := range genres {
+			if strings.EqualFold(g, genre) {
+				return
+			}
+		}
+
+		genres = append(genres, genre)
+	}
+
+	for _, value := range strings.Split(s, "\x00") {
+		if genre, ok := genreReference(strings.TrimSpace(value)); ok {
+			add(genre)
+
+			continue
+		}
+
+		for value != "" {
+			if strings.HasPrefix(value, "((") {
+				add(value[1:])
+
+				break
+			}
+
+			end := strings.Index(value, ")")
+			if !strings.HasPrefix(value, "(") || end < 0 {
+				add(value)
+
+				break
+			}
+
+			genre, ok := genreReference(value[1:end])
+			if !ok {
+				add(value)
+
+				break
+			}
+
+			add(genre)
+			value = value[end+1:]
+
+			if next := strings.Index(value, "("); next != 0 {
+				refinement := value
+				if next > 0 && !strings.HasPrefix(value[next:], "((") {
+					refinement, value = value[:next], value[next:]
+				} else {
+					value = ""
+				}
+
+				add(refinement)
+			}
+		}
+	}
+
+	return genres
+}
+
+// FormatContentType will format genres as ID3v2.2 and ID3v2.3 content type, like "(17)(RX)Eurodisco".
+// Known genres are referenced by id and other genres are joined by "/" as refinement text, because content type
+// has only one refinement text. ParseContentType returns the genres unchanged when there is at most one genre which
+// is not known, otherwise it is lossy, like ["Rock", "Foo", "Bar"] which is parsed back as ["Rock", "Foo/Bar"].
+func FormatContentType(genres []string) string {
+	refs, texts := "", make([]string, 0)
+
+	for _, genre := range genres {
+		switch {
+		case genre == "":
+		case strings.EqualFold(genre, GenreRemix):
+			refs += "(RX)"
+		case strings.EqualFold(genre, GenreCover):
+			refs += "(CR)"
+		default:
+			if id, ok := GenreID(genre); ok && normalizeGenre(Genres[id]) == normalizeGenre(genre) {
+				refs += "(" + strconv.Itoa(id) + ")"
+			} else {
+				texts = append(texts, genre)
+			}
+		}
+	}
+
+	text := strings.Join(texts, "/")
+	if strings.HasPrefix(text, "(") {
+		text = "(" + text
+	}
+
+	return refs + text
+}
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        []string
+	}{
+		{"", []string{}},
+		{"(17)", []string{"Rock"}},
+		{"(17)(RX)", []string{"Rock", GenreRemix}},
+		{"(CR)", []string{GenreCover}},
+		{"(4)Eurodisco", []string{"Disco", "Eurodisco"}},
+		{"(17)Rock", []string{"Rock"}},
+		{"((Text)", []string{"(Text)"}},
+		{"(17)((Text)", []string{"Rock", "(Text)"}},
+		{"17\x00RX\x00Rock", []string{"Rock", GenreRemix}},
+		{"Custom", []string{"Custom"}},
+		{"(191)", []string{"Psybient"}},
+		{"(192)Foo", []string{"(192)Foo"}},
+		{"(17", []string{"(17"}},
+	}
+
+	for _, tt := range tests {
+		if got := ParseContentType(tt.contentType); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseContentType(%q) = %q, want %q", tt.contentType, got, tt.want)
+		}
+	}
+}
+
+func TestFormatContentType(t *testing.T) {
+	tests := []struct {
+		genres []string
+		want   string
+		parsed []string
+	}{
+		{[]string{"Rock", GenreRemix, "Eurodisco"}, "(17)(RX)Eurodisco", []string{"Rock", GenreRemix, "Eurodisco"}},
+		{[]string{"hip hop", "cover"}, "(7)(CR)", []string{"Hip-Hop", GenreCover}},
+		{[]string{"(weird)"}, "((weird)", []string{"(weird)"}},
+		{[]string{"Pop/Funk"}, "(62)", []string{"Pop/Funk"}},
+		{[]string{}, "", []string{}},
+		// Only one refinement text, so multiple unknown genres are parsed back as one genre.
+		{[]string{"Rock", "Foo", "Bar"}, "(17)Foo/Bar", []string{"Rock", "Foo/Bar"}},
+	}
+
+	for _, tt := range tests {
+		got := FormatContentType(tt.genres)
+		if got != tt.want {
+			t.Errorf("FormatContentType(%q) = %q, want %q", tt.genres, got, tt.want)
+		}
+
+		if parsed := ParseContentType(got); !reflect.DeepEqual(parsed, tt.parsed) {
+			t.Errorf("ParseContentType(FormatContentType(%q)) = %q, want %q", tt.genres, parsed, tt.parsed)
+		}
+	}
+}
+
+func TestGenreID(t *testing.T) {
+	tests := []struct {
+		name string
+		id   int
+		ok   bool
+	}{
+		{"Rock", 17, true},
+		{"hiphop", 7, true},
+		{"Hip Hop", 7, true},
+		{"R&B", 14, true},
+		{"rock n roll", 78, true},
+		{"Psychedelic", 67, true},
+		{"Eurodanse", 54, true},
+		{"Rokc", 0, false},
+		{"xyz", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		id, ok := GenreID(tt.name)
+		if ok != tt.ok || (ok && id != tt.id) {
+			t.Errorf("GenreID(%q) = %d, %v, want %d, %v", tt.name, id, ok, tt.id, tt.ok)
+		}
+	}
+}
@@ -1,16 +1,15 @@
 package v22
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"image"
-	"image/jpeg"
-	"image/png"
 	"io"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/xonyagar/id3/lib"
 	v1 "github.com/xonyagar/id3/v1"
@@ -51,6 +50,8 @@ const (
 	TypeAudioEncryption
 	TypeLinkedInformation
 	TypeiTunesCompilationFlag
+	TypeUserDefinedTextInformation
+	TypeUserDefinedURLLink
 )
 
 type Frame interface {
@@ -94,6 +95,23 @@ func (f UniqueFileIdentifierFrame) Identifier() []byte {
 	return f.identifier
 }
 
+func NewUniqueFileIdentifierFrame(ownerIdentifier string, identifier []byte) UniqueFileIdentifierFrame {
+	frame := UniqueFileIdentifierFrame{
+		frameBase:       frameBase{id: "UFI"},
+		ownerIdentifier: ownerIdentifier,
+		identifier:      identifier,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f UniqueFileIdentifierFrame) Body() []byte {
+	body := append([]byte(f.ownerIdentifier), 0)
+
+	return append(body, f.identifier...)
+}
+
 type TextInformationFrame struct {
 	frameBase
 	encoding lib.Encoding
@@ -104,6 +122,64 @@ func (f TextInformationFrame) Text() string {
 	return f.text
 }
 
+// NewTextInformationFrame will return text information frame, multiple values are separated by "/".
+func NewTextInformationFrame(id string, values ...string) TextInformationFrame {
+	frame := TextInformationFrame{
+		frameBase: frameBase{id: id},
+		encoding:  lib.Encodings[1],
+		text:      strings.Join(values, "/"),
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f TextInformationFrame) Body() []byte {
+	return append([]byte{f.encoding.Byte()}, lib.FromUTF8(f.text, f.encoding)...)
+}
+
+type UserDefinedTextInformationFrame struct {
+	frameBase
+	encoding    lib.Encoding
+	description string
+	value       string
+}
+
+func (f UserDefinedTextInformationFrame) Description() string {
+	return f.description
+}
+
+func (f UserDefinedTextInformationFrame) Value() string {
+	return f.value
+}
+
+// NewUserDefinedTextInformationFrame will return user defined text information frame,
+// multiple values are separated by "/".
+func NewUserDefinedTextInformationFrame(description string, values ...string) UserDefinedTextInformationFrame {
+	frame := UserDefinedTextInformationFrame{
+		frameBase:   frameBase{id: "TXX"},
+		encoding:    lib.Encodings[1],
+		description: description,
+		value:       strings.Join(values, "/"),
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f UserDefinedTextInformationFrame) Body() []byte {
+	body := append([]byte{f.encoding.Byte()}, lib.FromUTF8(f.description, f.encoding)...)
+	body = append(body, f.encoding.Termination()...)
+
+	return append(body, lib.FromUTF8(f.value, f.encoding)...)
+}
+
+// InvolvedPerson is an involvement and its involvee, like "producer" and "John Doe".
+type InvolvedPerson struct {
+	Involvement string
+	Involvee    string
+}
+
 type InvolvedPeopleListFrame struct {
 	frameBase
 	encoding   lib.Encoding
@@ -114,6 +190,20 @@ func (f InvolvedPeopleListFrame) PeopleList() []string {
 	return f.peopleList
 }
 
+// People will return people list as ordered involvement and involvee pairs.
+func (f InvolvedPeopleListFrame) People() []InvolvedPerson {
+	people := make([]InvolvedPerson, 0, len(f.peopleList)/2)
+
+	for i := 0; i+1 < len(f.peopleList); i += 2 {
+		people = append(people, InvolvedPerson{
+			Involvement: f.peopleList[i],
+			Involvee:    f.peopleList[i+1],
+		})
+	}
+
+	return people
+}
+
 type URLLinkFrame struct {
 	frameBase
 	url string
@@ -123,6 +213,21 @@ func (f URLLinkFrame) URL() string {
 	return f.url
 }
 
+type UserDefinedURLLinkFrame struct {
+	frameBase
+	encoding    lib.Encoding
+	description string
+	url         string
+}
+
+func (f UserDefinedURLLinkFrame) Description() string {
+	return f.description
+}
+
+func (f UserDefinedURLLinkFrame) URL() string {
+	return f.url
+}
+
 type MusicCDIdentifierFrame struct {
 	frameBase
 	cdTOC []byte
@@ -132,20 +237,164 @@ func (f MusicCDIdentifierFrame) CDTOC() []byte {
 	return f.cdTOC
 }
 
+// TOC will decode CD table of contents.
+func (f MusicCDIdentifierFrame) TOC() (lib.TOC, error) {
+	return lib.ParseTOC(f.cdTOC)
+}
+
 type TimeStampFormat byte
 
+const (
+	TimeStampFormatMPEGFrames   TimeStampFormat = 1
+	TimeStampFormatMilliseconds TimeStampFormat = 2
+)
+
+// Duration will convert time stamp to duration, frameDuration is used for MPEG frames time stamps.
+func (f TimeStampFormat) Duration(timeStamp int, frameDuration time.Duration) time.Duration {
+	if f == TimeStampFormatMPEGFrames {
+		return time.Duration(timeStamp) * frameDuration
+	}
+
+	return time.Duration(timeStamp) * time.Millisecond
+}
+
+// TimeStamp will convert duration to time stamp, frameDuration is used for MPEG frames time stamps.
+func (f TimeStampFormat) TimeStamp(d time.Duration, frameDuration time.Duration) int {
+	if f == TimeStampFormatMPEGFrames {
+		if frameDuration == 0 {
+			return 0
+		}
+
+		return int(d / frameDuration)
+	}
+
+	return int(d / time.Millisecond)
+}
+
+type EventType byte
+
+const (
+	EventTypePadding                EventType = 0x00
+	EventTypeEndOfInitialSilence    EventType = 0x01
+	EventTypeIntroStart             EventType = 0x02
+	EventTypeMainPartStart          EventType = 0x03
+	EventTypeOutroStart             EventType = 0x04
+	EventTypeOutroEnd               EventType = 0x05
+	EventTypeVerseStart             EventType = 0x06
+	EventTypeRefrainStart           EventType = 0x07
+	EventTypeInterlude              EventType = 0x08
+	EventTypeThemeStart             EventType = 0x09
+	EventTypeVariation              EventType = 0x0a
+	EventTypeKeyChange              EventType = 0x0b
+	EventTypeTimeChange             EventType = 0x0c
+	EventTypeMomentaryUnwantedNoise EventType = 0x0d
+	EventTypeAudioEnd               EventType = 0xfd
+	EventTypeAudioFileEnds          EventType = 0xfe
+)
+
+type Event struct {
+	Type      EventType
+	TimeStamp int
+}
+
 type EventTimingCodesFrame struct {
 	frameBase
 	timeStampFormat TimeStampFormat
+	events          []Event
 }
 
 func (f EventTimingCodesFrame) TimeStampFormat() TimeStampFormat {
 	return f.timeStampFormat
 }
 
-// 4.7.   MPEG location lookup table
+func (f EventTimingCodesFrame) Events() []Event {
+	return f.events
+}
+
+// 4.7.   MPEG location lookup table.
+type MPEGLocationLookupTableReference struct {
+	BytesDeviation        int
+	MillisecondsDeviation int
+}
+
+type MPEGLocationLookupTableFrame struct {
+	frameBase
+	mpegFramesBetweenReference   int
+	bytesBetweenReference        int
+	millisecondsBetweenReference int
+	bitsForBytesDeviation        int
+	bitsForMillisecondsDeviation int
+	references                   []MPEGLocationLookupTableReference
+}
+
+func (f MPEGLocationLookupTableFrame) MPEGFramesBetweenReference() int {
+	return f.mpegFramesBetweenReference
+}
+
+func (f MPEGLocationLookupTableFrame) BytesBetweenReference() int {
+	return f.bytesBetweenReference
+}
+
+func (f MPEGLocationLookupTableFrame) MillisecondsBetweenReference() int {
+	return f.millisecondsBetweenReference
+}
+
+func (f MPEGLocationLookupTableFrame) BitsForBytesDeviation() int {
+	return f.bitsForBytesDeviation
+}
+
+func (f MPEGLocationLookupTableFrame) BitsForMillisecondsDeviation() int {
+	return f.bitsForMillisecondsDeviation
+}
+
+func (f MPEGLocationLookupTableFrame) References() []MPEGLocationLookupTableReference {
+	return f.references
+}
+
+// Seek will return byte offset of d from the first MPEG frame of audio.
+// Offsets between references are interpolated and offsets after the last reference are extrapolated.
+func (f MPEGLocationLookupTableFrame) Seek(d time.Duration) int {
+	ms := int(d / time.Millisecond)
+	offset, t := 0, 0
+
+	for _, r := range f.references {
+		nextOffset := offset + f.bytesBetweenReference + r.BytesDeviation
+		nextT := t + f.millisecondsBetweenReference + r.MillisecondsDeviation
+
+		if nextT > ms {
+			return offset + (nextOffset-offset)*(ms-t)/(nextT-t)
+		}
+
+		offset, t = nextOffset, nextT
+	}
+
+	if f.millisecondsBetweenReference == 0 {
+		return offset
+	}
+
+	return offset + f.bytesBetweenReference*(ms-t)/f.millisecondsBetweenReference
+}
+
+// 4.8.   Synced tempo codes.
+type Tempo struct {
+	// BPM is beats per minute, 0 means beat-free and 1 means a single beat-stroke followed by a beat-free period.
+	BPM       int
+	TimeStamp int
+}
+
+type SyncedTempoCodesFrame struct {
+	frameBase
+	timeStampFormat TimeStampFormat
+	tempos          []Tempo
+}
+
+func (f SyncedTempoCodesFrame) TimeStampFormat() TimeStampFormat {
+	return f.timeStampFormat
+}
 
-// 4.8.   Synced tempo codes
+func (f SyncedTempoCodesFrame) Tempos() []Tempo {
+	return f.tempos
+}
 
 type UnsynchronisedLyricsOrTextTranscriptionFrame struct {
 	frameBase
@@ -167,7 +416,77 @@ func (f UnsynchronisedLyricsOrTextTranscriptionFrame) LyricsOrText() string {
 	return f.lyricsOrText
 }
 
-// 4.10.   Synchronised lyrics/text
+// NewUnsynchronisedLyricsOrTextTranscriptionFrame will return unsynchronised lyrics frame,
+// language is ISO 639-2 code and "XXX" is used for unknown languages.
+func NewUnsynchronisedLyricsOrTextTranscriptionFrame(
+	language, contentDescriptor, lyricsOrText string,
+) UnsynchronisedLyricsOrTextTranscriptionFrame {
+	frame := UnsynchronisedLyricsOrTextTranscriptionFrame{
+		frameBase:         frameBase{id: "ULT"},
+		textEncoding:      lib.Encodings[1],
+		language:          languageCode(language),
+		contentDescriptor: contentDescriptor,
+		lyricsOrText:      lyricsOrText,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f UnsynchronisedLyricsOrTextTranscriptionFrame) Body() []byte {
+	body := append([]byte{f.textEncoding.Byte()}, f.language...)
+	body = append(body, lib.FromUTF8(f.contentDescriptor, f.textEncoding)...)
+	body = append(body, f.textEncoding.Termination()...)
+
+	return append(body, lib.FromUTF8(f.lyricsOrText, f.textEncoding)...)
+}
+
+// 4.10.   Synchronised lyrics/text.
+type ContentType byte
+
+const (
+	ContentTypeOther             ContentType = 0x00
+	ContentTypeLyrics            ContentType = 0x01
+	ContentTypeTextTranscription ContentType = 0x02
+	ContentTypeMovementOrPart    ContentType = 0x03
+	ContentTypeEvents            ContentType = 0x04
+	ContentTypeChord             ContentType = 0x05
+)
+
+type SynchronisedText struct {
+	Text      string
+	TimeStamp int
+}
+
+type SynchronisedLyricsOrTextFrame struct {
+	frameBase
+	textEncoding      lib.Encoding
+	language          string
+	timeStampFormat   TimeStampFormat
+	contentType       ContentType
+	contentDescriptor string
+	texts             []SynchronisedText
+}
+
+func (f SynchronisedLyricsOrTextFrame) Language() string {
+	return f.language
+}
+
+func (f SynchronisedLyricsOrTextFrame) TimeStampFormat() TimeStampFormat {
+	return f.timeStampFormat
+}
+
+func (f SynchronisedLyricsOrTextFrame) ContentType() ContentType {
+	return f.contentType
+}
+
+func (f SynchronisedLyricsOrTextFrame) ContentDescriptor() string {
+	return f.contentDescriptor
+}
+
+func (f SynchronisedLyricsOrTextFrame) Texts() []SynchronisedText {
+	return f.texts
+}
 
 type CommentsFrame struct {
 	frameBase
@@ -189,13 +508,151 @@ func (f CommentsFrame) TheActualText() string {
 	return f.theActualText
 }
 
-// 4.12.   Relative volume adjustment
+// NewCommentsFrame will return comments frame, language is ISO 639-2 code and "XXX" is used for unknown languages.
+func NewCommentsFrame(language, shortContentDescription, theActualText string) CommentsFrame {
+	frame := CommentsFrame{
+		frameBase:               frameBase{id: "COM"},
+		textEncoding:            lib.Encodings[1],
+		language:                languageCode(language),
+		shortContentDescription: shortContentDescription,
+		theActualText:           theActualText,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f CommentsFrame) Body() []byte {
+	body := append([]byte{f.textEncoding.Byte()}, f.language...)
+	body = append(body, lib.FromUTF8(f.shortContentDescription, f.textEncoding)...)
+	body = append(body, f.textEncoding.Termination()...)
+
+	return append(body, lib.FromUTF8(f.theActualText, f.textEncoding)...)
+}
+
+// languageCode will return language as three characters ISO 639-2 code, "XXX" for unknown languages.
+func languageCode(language string) string {
+	if len(language) != 3 {
+		return "XXX"
+	}
+
+	return language
+}
+
+// 4.12.   Relative volume adjustment.
+type RelativeVolumeAdjustmentFrame struct {
+	frameBase
+	bitsUsedForVolume int
+	volumeChangeRight int
+	volumeChangeLeft  int
+	peakVolumeRight   int
+	peakVolumeLeft    int
+}
+
+func (f RelativeVolumeAdjustmentFrame) BitsUsedForVolume() int {
+	return f.bitsUsedForVolume
+}
+
+// VolumeChangeRight is relative volume change of right channel, negative for decrement.
+func (f RelativeVolumeAdjustmentFrame) VolumeChangeRight() int {
+	return f.volumeChangeRight
+}
+
+// VolumeChangeLeft is relative volume change of left channel, negative for decrement.
+func (f RelativeVolumeAdjustmentFrame) VolumeChangeLeft() int {
+	return f.volumeChangeLeft
+}
+
+func (f RelativeVolumeAdjustmentFrame) PeakVolumeRight() int {
+	return f.peakVolumeRight
+}
+
+func (f RelativeVolumeAdjustmentFrame) PeakVolumeLeft() int {
+	return f.peakVolumeLeft
+}
+
+// 4.13.   Equalisation.
+type EqualisationBand struct {
+	// Increment is true for increment and false for decrement of volume.
+	Increment bool
+	// Frequency is in Hz.
+	Frequency  int
+	Adjustment int
+}
+
+type EqualisationFrame struct {
+	frameBase
+	adjustmentBits int
+	bands          []EqualisationBand
+}
+
+func (f EqualisationFrame) AdjustmentBits() int {
+	return f.adjustmentBits
+}
+
+func (f EqualisationFrame) Bands() []EqualisationBand {
+	return f.bands
+}
+
+// 4.14.   Reverb.
+type ReverbFrame struct {
+	frameBase
+	reverbLeft           int
+	reverbRight          int
+	reverbBouncesLeft    uint8
+	reverbBouncesRight   uint8
+	feedbackLeftToLeft   uint8
+	feedbackLeftToRight  uint8
+	feedbackRightToRight uint8
+	feedbackRightToLeft  uint8
+	premixLeftToRight    uint8
+	premixRightToLeft    uint8
+}
+
+// ReverbLeft is delay between bounces in milliseconds.
+func (f ReverbFrame) ReverbLeft() int {
+	return f.reverbLeft
+}
+
+// ReverbRight is delay between bounces in milliseconds.
+func (f ReverbFrame) ReverbRight() int {
+	return f.reverbRight
+}
+
+func (f ReverbFrame) ReverbBouncesLeft() uint8 {
+	return f.reverbBouncesLeft
+}
+
+func (f ReverbFrame) ReverbBouncesRight() uint8 {
+	return f.reverbBouncesRight
+}
+
+func (f ReverbFrame) FeedbackLeftToLeft() uint8 {
+	return f.feedbackLeftToLeft
+}
+
+func (f ReverbFrame) FeedbackLeftToRight() uint8 {
+	return f.feedbackLeftToRight
+}
+
+func (f ReverbFrame) FeedbackRightToRight() uint8 {
+	return f.feedbackRightToRight
+}
+
+func (f ReverbFrame) FeedbackRightToLeft() uint8 {
+	return f.feedbackRightToLeft
+}
 
-// 4.13.   Equalisation
+func (f ReverbFrame) PremixLeftToRight() uint8 {
+	return f.premixLeftToRight
+}
 
-// 4.14.   Reverb
+func (f ReverbFrame) PremixRightToLeft() uint8 {
+	return f.premixRightToLeft
+}
 
-type PictureType int
+// PictureType is type of attached picture.
+type PictureType = lib.PictureType
 
 const (
 	PictureTypeOther PictureType = iota
@@ -230,106 +687,279 @@ type AttachedPictureFrame struct {
 	pictureData  []byte
 }
 
+// Image will decode picture, format is sniffed from picture data and lib.ErrPictureLink is returned for links.
 func (f AttachedPictureFrame) Image() (image.Image, error) {
-	switch f.imageFormat {
-	case "JPG":
-		res, err := jpeg.Decode(bytes.NewReader(f.pictureData))
-		if err != nil {
-			return nil, fmt.Errorf("error on decode jpeg: %w", err)
-		}
+	res, err := lib.DecodeImage(f.imageFormat, f.pictureData)
+	if err != nil {
+		return nil, fmt.Errorf("error on decode picture: %w", err)
+	}
 
-		return res, nil
-	case "PNG":
-		res, err := png.Decode(bytes.NewReader(f.pictureData))
-		if err != nil {
-			return nil, fmt.Errorf("error on decode png: %w", err)
-		}
+	return res, nil
+}
 
-		return res, nil
-	default:
-		return nil, errors.New("invalid image format")
+// Link will return URL of picture when picture is a link, which MIME type is "-->".
+func (f AttachedPictureFrame) Link() (string, bool) {
+	if strings.TrimSpace(f.imageFormat) != lib.PictureLinkMIMEType {
+		return "", false
 	}
+
+	return strings.TrimRight(string(f.pictureData), "\x00"), true
+}
+
+// ImageFormat is three characters image format, like "PNG" or "JPG".
+func (f AttachedPictureFrame) ImageFormat() string {
+	return f.imageFormat
+}
+
+func (f AttachedPictureFrame) PictureType() PictureType {
+	return f.pictureType
+}
+
+// Type is picture type, like front cover, same as PictureType.
+func (f AttachedPictureFrame) Type() PictureType {
+	return f.pictureType
+}
+
+// MIMEType is MIME type of image format, like "image/png" for "PNG" and "image/jpeg" for "JPG".
+func (f AttachedPictureFrame) MIMEType() string {
+	return lib.ImageMIMEType(f.imageFormat)
 }
 
 func (f AttachedPictureFrame) Description() string {
 	return f.description
 }
 
-// 4.16.   General encapsulated object
+func (f AttachedPictureFrame) PictureData() []byte {
+	return f.pictureData
+}
+
+// Data is raw bytes of picture, same as PictureData.
+func (f AttachedPictureFrame) Data() []byte {
+	return f.pictureData
+}
+
+// 4.16.   General encapsulated object.
+type GeneralEncapsulatedObjectFrame struct {
+	frameBase
+	textEncoding       lib.Encoding
+	mimeType           string
+	filename           string
+	contentDescription string
+	encapsulatedObject []byte
+}
 
-// 4.17.   Play counter
+func (f GeneralEncapsulatedObjectFrame) MIMEType() string {
+	return f.mimeType
+}
 
-// 4.18.   Popularimeter
+func (f GeneralEncapsulatedObjectFrame) Filename() string {
+	return f.filename
+}
 
-// 4.19.   Recommended buffer size
+func (f GeneralEncapsulatedObjectFrame) Description() string {
+	return f.contentDescription
+}
 
-// 4.20.   Encrypted meta frame
+func (f GeneralEncapsulatedObjectFrame) Data() []byte {
+	return f.encapsulatedObject
+}
 
-// 4.21.   Audio encryption
+// Extract will write encapsulated object into dir and return the written file path, existing files are not overwritten.
+// Content description is used as file name when object has no filename.
+func (f GeneralEncapsulatedObjectFrame) Extract(dir string) (string, error) {
+	name := f.filename
+	if name == "" {
+		name = f.contentDescription
+	}
 
-// 4.22.   Linked information
+	return lib.WriteFile(dir, name, f.encapsulatedObject)
+}
 
-type ItunesCompilationFlagFrame struct {
+// 4.17.   Play counter.
+type PlayCounterFrame struct {
 	frameBase
-	encoding             lib.Encoding
-	isPartOfACompilation bool
+	counter int
 }
 
-func (f ItunesCompilationFlagFrame) IsPartOfACompilation() bool {
-	return f.isPartOfACompilation
+func (f PlayCounterFrame) Counter() int {
+	return f.counter
 }
 
-type DeclaredFrame struct {
-	ID          string
-	Description string
-	Type        FrameType
+// 4.18.   Popularimeter.
+type PopularimeterFrame struct {
+	frameBase
+	emailToUser string
+	rating      uint8
+	counter     int
 }
 
-var DeclaredFrames = map[string]DeclaredFrame{
-	"BUF": {"BUF", "Recommended buffer size", TypeUnknown},
-	"CNT": {"CNT", "Play counter", TypeUnknown},
-	"COM": {"COM", "Comments", TypeComments},
-	"CRA": {"CRA", "Audio encryption", TypeUnknown},
-	"CRM": {"CRM", "Encrypted meta frame", TypeUnknown},
-	"ETC": {"ETC", "Event timing codes", TypeUnknown},
-	"EQU": {"EQU", "Equalization", TypeUnknown},
-	"GEO": {"GEO", "General encapsulated object", TypeUnknown},
-	"IPL": {"IPL", "Involved people list", TypeInvolvedPeopleList},
-	"LNK": {"LNK", "Linked information", TypeUnknown},
-	"MCI": {"MCI", "Music CD Identifier", TypeUnknown},
-	"MLL": {"MLL", "MPEG location lookup table", TypeUnknown},
-	"PIC": {"PIC", "Attached picture", TypeAttachedPicture},
-	"POP": {"POP", "Popularimeter", TypeUnknown},
-	"REV": {"REV", "Reverb", TypeUnknown},
-	"RVA": {"RVA", "Relative volume adjustment", TypeUnknown},
-	"SLT": {"SLT", "Synchronized lyric/text", TypeUnknown},
-	"STC": {"STC", "Synced tempo codes", TypeUnknown},
+func (f PopularimeterFrame) EmailToUser() string {
+	return f.emailToUser
+}
 
-	"TAL": {"TAL", "Album/Movie/Show title", TypeTextInformation},
-	"TBP": {"TBP", "BPM (Beats Per Minute)", TypeTextInformation},
-	"TCM": {"TCM", "Composer", TypeTextInformation},
-	"TCO": {"TCO", "Content type", TypeTextInformation},
-	"TCR": {"TCR", "Copyright message", TypeTextInformation},
-	"TDA": {"TDA", "Date", TypeTextInformation},
-	"TDY": {"TDY", "Playlist delay", TypeTextInformation},
-	"TEN": {"TEN", "Encoded by", TypeTextInformation},
-	"TFT": {"TFT", "File type", TypeTextInformation},
-	"TIM": {"TIM", "Time", TypeTextInformation},
-	"TKE": {"TKE", "Initial key", TypeTextInformation},
-	"TLA": {"TLA", "Language(s)", TypeTextInformation},
-	"TLE": {"TLE", "Length", TypeTextInformation},
-	"TMT": {"TMT", "Media type", TypeTextInformation},
-	"TOA": {"TOA", "Original artist(s)/performer(s)", TypeTextInformation},
-	"TOF": {"TOF", "Original filename", TypeTextInformation},
-	"TOL": {"TOL", "Original Lyricist(s)/text writer(s)", TypeTextInformation},
-	"TOR": {"TOR", "Original release year", TypeTextInformation},
-	"TOT": {"TOT", "Original album/Movie/Show title", TypeTextInformation},
-	"TP1": {"TP1", "Lead artist(s)/Lead performer(s)/Soloist(s)/Performing group", TypeTextInformation},
-	"TP2": {"TP2", "Band/Orchestra/Accompaniment", TypeTextInformation},
-	"TP3": {"TP3", "Conductor/Performer refinement", TypeTextInformation},
-	"TP4": {"TP4", "Interpreted, remixed, or otherwise modified by", TypeTextInformation},
-	"TPA": {"TPA", "Part of a set", TypeTextInformation},
-	"TPB": {"TPB", "Publisher", TypeTextInformation},
+func (f PopularimeterFrame) Rating() uint8 {
+	return f.rating
+}
+
+func (f PopularimeterFrame) Counter() int {
+	return f.counter
+}
+
+// 4.19.   Recommended buffer size.
+type RecommendedBufferSizeFrame struct {
+	frameBase
+	bufferSize      int
+	embeddedInfo    bool
+	offsetToNextTag int
+}
+
+// BufferSize is recommended buffer size in bytes.
+func (f RecommendedBufferSizeFrame) BufferSize() int {
+	return f.bufferSize
+}
+
+// EmbeddedInfo reports whether a tag with a maximum size of buffer size may occur in the audio stream.
+func (f RecommendedBufferSizeFrame) EmbeddedInfo() bool {
+	return f.embeddedInfo
+}
+
+// OffsetToNextTag is offset from end of tag to next tag in bytes, zero if it is not known.
+func (f RecommendedBufferSizeFrame) OffsetToNextTag() int {
+	return f.offsetToNextTag
+}
+
+// 4.20.   Encrypted meta frame.
+type EncryptedMetaFrame struct {
+	frameBase
+	ownerIdentifier    string
+	content            string
+	encryptedDatablock []byte
+}
+
+func (f EncryptedMetaFrame) OwnerIdentifier() string {
+	return f.ownerIdentifier
+}
+
+// Content is a short explanation of encrypted content.
+func (f EncryptedMetaFrame) Content() string {
+	return f.content
+}
+
+func (f EncryptedMetaFrame) EncryptedDatablock() []byte {
+	return f.encryptedDatablock
+}
+
+// 4.21.   Audio encryption.
+type AudioEncryptionFrame struct {
+	frameBase
+	ownerIdentifier string
+	previewStart    int
+	previewLength   int
+	encryptionInfo  []byte
+}
+
+func (f AudioEncryptionFrame) OwnerIdentifier() string {
+	return f.ownerIdentifier
+}
+
+// PreviewStart is start of unencrypted part of audio in frames.
+func (f AudioEncryptionFrame) PreviewStart() int {
+	return f.previewStart
+}
+
+// PreviewLength is length of unencrypted part of audio in frames.
+func (f AudioEncryptionFrame) PreviewLength() int {
+	return f.previewLength
+}
+
+func (f AudioEncryptionFrame) EncryptionInfo() []byte {
+	return f.encryptionInfo
+}
+
+// 4.22.   Linked information.
+type LinkedInformationFrame struct {
+	frameBase
+	frameIdentifier string
+	url             string
+	idData          []byte
+}
+
+// FrameIdentifier is id of the linked frame.
+func (f LinkedInformationFrame) FrameIdentifier() string {
+	return f.frameIdentifier
+}
+
+// URL is location of file which contains the linked frame.
+func (f LinkedInformationFrame) URL() string {
+	return f.url
+}
+
+// IDData is additional data which identifies the linked frame, like content descriptor of attached picture.
+func (f LinkedInformationFrame) IDData() []byte {
+	return f.idData
+}
+
+type ItunesCompilationFlagFrame struct {
+	frameBase
+	encoding             lib.Encoding
+	isPartOfACompilation bool
+}
+
+func (f ItunesCompilationFlagFrame) IsPartOfACompilation() bool {
+	return f.isPartOfACompilation
+}
+
+type DeclaredFrame struct {
+	ID          string
+	Description string
+	Type        FrameType
+}
+
+var DeclaredFrames = map[string]DeclaredFrame{
+	"BUF": {"BUF", "Recommended buffer size", TypeRecommendedBufferSize},
+	"CNT": {"CNT", "Play counter", TypePlayCounter},
+	"COM": {"COM", "Comments", TypeComments},
+	"CRA": {"CRA", "Audio encryption", TypeAudioEncryption},
+	"CRM": {"CRM", "Encrypted meta frame", TypeEncryptedMetaFrame},
+	"ETC": {"ETC", "Event timing codes", TypeEventTimingCodes},
+	"EQU": {"EQU", "Equalization", TypeEqualisation},
+	"GEO": {"GEO", "General encapsulated object", TypeGeneralEncapsulatedObject},
+	"IPL": {"IPL", "Involved people list", TypeInvolvedPeopleList},
+	"LNK": {"LNK", "Linked information", TypeLinkedInformation},
+	"MCI": {"MCI", "Music CD Identifier", TypeMusicCDIdentifier},
+	"MLL": {"MLL", "MPEG location lookup table", TypeMPEGLocationLookupTable},
+	"PIC": {"PIC", "Attached picture", TypeAttachedPicture},
+	"POP": {"POP", "Popularimeter", TypePopularimeter},
+	"REV": {"REV", "Reverb", TypeReverb},
+	"RVA": {"RVA", "Relative volume adjustment", TypeRelativeVolumeAdjustment},
+	"SLT": {"SLT", "Synchronized lyric/text", TypeSynchronisedLyricsOrText},
+	"STC": {"STC", "Synced tempo codes", TypeSyncedTempoCodes},
+
+	"TAL": {"TAL", "Album/Movie/Show title", TypeTextInformation},
+	"TBP": {"TBP", "BPM (Beats Per Minute)", TypeTextInformation},
+	"TCM": {"TCM", "Composer", TypeTextInformation},
+	"TCO": {"TCO", "Content type", TypeTextInformation},
+	"TCR": {"TCR", "Copyright message", TypeTextInformation},
+	"TDA": {"TDA", "Date", TypeTextInformation},
+	"TDY": {"TDY", "Playlist delay", TypeTextInformation},
+	"TEN": {"TEN", "Encoded by", TypeTextInformation},
+	"TFT": {"TFT", "File type", TypeTextInformation},
+	"TIM": {"TIM", "Time", TypeTextInformation},
+	"TKE": {"TKE", "Initial key", TypeTextInformation},
+	"TLA": {"TLA", "Language(s)", TypeTextInformation},
+	"TLE": {"TLE", "Length", TypeTextInformation},
+	"TMT": {"TMT", "Media type", TypeTextInformation},
+	"TOA": {"TOA", "Original artist(s)/performer(s)", TypeTextInformation},
+	"TOF": {"TOF", "Original filename", TypeTextInformation},
+	"TOL": {"TOL", "Original Lyricist(s)/text writer(s)", TypeTextInformation},
+	"TOR": {"TOR", "Original release year", TypeTextInformation},
+	"TOT": {"TOT", "Original album/Movie/Show title", TypeTextInformation},
+	"TP1": {"TP1", "Lead artist(s)/Lead performer(s)/Soloist(s)/Performing group", TypeTextInformation},
+	"TP2": {"TP2", "Band/Orchestra/Accompaniment", TypeTextInformation},
+	"TP3": {"TP3", "Conductor/Performer refinement", TypeTextInformation},
+	"TP4": {"TP4", "Interpreted, remixed, or otherwise modified by", TypeTextInformation},
+	"TPA": {"TPA", "Part of a set", TypeTextInformation},
+	"TPB": {"TPB", "Publisher", TypeTextInformation},
 	"TRC": {"TRC", "ISRC (International Standard Recording Code)", TypeTextInformation},
 	"TRD": {"TRD", "Recording dates", TypeTextInformation},
 	"TRK": {"TRK", "Track number/Position in set", TypeTextInformation},
@@ -339,9 +969,14 @@ var DeclaredFrames = map[string]DeclaredFrame{
 	"TT2": {"TT2", "Title/Songname/Content description", TypeTextInformation},
 	"TT3": {"TT3", "Subtitle/Description refinement", TypeTextInformation},
 	"TXT": {"TXT", "Lyricist/text writer", TypeTextInformation},
-	"TXX": {"TXX", "User defined text information frame", TypeUnknown},
+	"TXX": {"TXX", "User defined text information frame", TypeUserDefinedTextInformation},
 	"TYE": {"TYE", "Year", TypeTextInformation},
 	"TCP": {"TCP", "Part of a compilation", TypeiTunesCompilationFlag},
+	"TS2": {"TS2", "Album artist sort order", TypeTextInformation},
+	"TSA": {"TSA", "Album sort order", TypeTextInformation},
+	"TSC": {"TSC", "Composer sort order", TypeTextInformation},
+	"TSP": {"TSP", "Performer sort order", TypeTextInformation},
+	"TST": {"TST", "Title sort order", TypeTextInformation},
 
 	"UFI": {"UFI", "Unique file identifier", TypeUniqueFileIdentifier},
 	"ULT": {"ULT", "Unsychronized lyric/text transcription", TypeUnsychronisedLyricsOrTextTranscription},
@@ -352,7 +987,7 @@ var DeclaredFrames = map[string]DeclaredFrame{
 	"WCM": {"WCM", "Commercial information", TypeURLLink},
 	"WCP": {"WCP", "Copyright/Legal information", TypeURLLink},
 	"WPB": {"WPB", "Publishers official webpage", TypeURLLink},
-	"WXX": {"WXX", "User defined URL link frame", TypeUnknown},
+	"WXX": {"WXX", "User defined URL link frame", TypeUserDefinedURLLink},
 }
 
 // Tag is ID3v2.2 tag reader.
@@ -381,7 +1016,7 @@ func New(f io.ReadSeeker) (*Tag, error) {
 	}
 
 	frames := make([]Frame, 0)
-	framesSize := lib.ByteToInt(header[6:10])
+	framesSize := lib.SyncsafeToInt(header[6:10])
 
 	for t := 0; t < framesSize; {
 		frameHeader := make([]byte, FrameHeaderSize)
@@ -418,269 +1053,1243 @@ func New(f io.ReadSeeker) (*Tag, error) {
 			size: frameSize,
 		}
 
-		df, ok := DeclaredFrames[frameID]
-		if !ok {
-			frame := UnknownFrame{
-				frameBase: frameBase,
-				data:      frameBody,
-			}
-			frames = append(frames, frame)
+		frames = append(frames, newFrame(frameBase, frameBody))
+	}
 
-			continue
+	tag := new(Tag)
+	tag.frames = frames
+	tag.size = framesSize
+
+	return tag, nil
+}
+
+// encodedFrameTypes are frame types which body starts with a text encoding byte, bodies with an invalid
+// encoding are unknown frames.
+var encodedFrameTypes = map[FrameType]bool{
+	TypeGeneralEncapsulatedObject:              true,
+	TypeComments:                               true,
+	TypeUnsychronisedLyricsOrTextTranscription: true,
+	TypeInvolvedPeopleList:                     true,
+	TypeiTunesCompilationFlag:                  true,
+	TypeSynchronisedLyricsOrText:               true,
+}
+
+func newFrame(frameBase frameBase, frameBody []byte) Frame {
+	frameSize := len(frameBody)
+
+	df, ok := DeclaredFrames[frameBase.id]
+	if !ok || len(frameBody) == 0 {
+		frame := UnknownFrame{
+			frameBase: frameBase,
+			data:      frameBody,
 		}
 
-		switch df.Type {
-		case TypeTextInformation:
-			frame := TextInformationFrame{
-				frameBase: frameBase,
-				encoding:  lib.Encodings[frameBody[0]],
-				text:      lib.ToUTF8(frameBody[1:], lib.Encodings[frameBody[0]]),
-			}
-			frames = append(frames, frame)
-		case TypeURLLink:
-			frame := URLLinkFrame{
-				frameBase: frameBase,
-				url:       string(frameBody),
-			}
-			frames = append(frames, frame)
-		case TypeAttachedPicture:
-			frame := AttachedPictureFrame{
-				frameBase:    frameBase,
-				textEncoding: lib.Encodings[frameBody[0]],
-				imageFormat:  string(frameBody[1:4]),
-				pictureType:  PictureType(frameBody[4]),
-			}
+		return frame
+	}
 
-			for i := 5; i < frameSize; i += frame.textEncoding.Size {
-				if frameBody[i] == 0 {
-					frame.description = lib.ToUTF8(frameBody[5:i], frame.textEncoding)
-					frame.pictureData = frameBody[i+frame.textEncoding.Size:]
+	if encodedFrameTypes[df.Type] && !lib.IsValidEncoding(frameBody[0]) {
+		return UnknownFrame{frameBase: frameBase, data: frameBody}
+	}
 
-					break
-				}
-			}
+	switch df.Type {
+	case TypeTextInformation:
+		frame := TextInformationFrame{
+			frameBase: frameBase,
+			encoding:  lib.Encodings[frameBody[0]],
+			text:      lib.ToUTF8(frameBody[1:], lib.Encodings[frameBody[0]]),
+		}
 
-			frames = append(frames, frame)
-		case TypeUnsychronisedLyricsOrTextTranscription:
-			frame := UnsynchronisedLyricsOrTextTranscriptionFrame{
-				frameBase:    frameBase,
-				textEncoding: lib.Encodings[frameBody[0]],
-				language:     string(frameBody[1:4]),
-			}
+		return frame
+	case TypeUserDefinedTextInformation:
+		frame := UserDefinedTextInformationFrame{
+			frameBase: frameBase,
+			encoding:  lib.Encodings[frameBody[0]],
+		}
 
-			for i := 4; i < frameSize; i += frame.textEncoding.Size {
-				if frameBody[i] == 0 {
-					frame.contentDescriptor = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
-					frame.lyricsOrText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
+		description, value := lib.SplitTerminated(frameBody[1:], frame.encoding)
+		frame.description = lib.ToUTF8(description, frame.encoding)
+		frame.value = lib.ToUTF8(value, frame.encoding)
 
-					break
-				}
-			}
+		return frame
+	case TypeURLLink:
+		frame := URLLinkFrame{
+			frameBase: frameBase,
+			url:       string(frameBody),
+		}
 
-			frames = append(frames, frame)
-		case TypeComments:
-			frame := CommentsFrame{
-				frameBase:    frameBase,
-				textEncoding: lib.Encodings[frameBody[0]],
-				language:     string(frameBody[1:4]),
-			}
+		return frame
+	case TypeAttachedPicture:
+		frame := AttachedPictureFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+			imageFormat:  string(frameBody[1:4]),
+			pictureType:  PictureType(frameBody[4]),
+		}
 
-			for i := 4; i < frameSize; i += frame.textEncoding.Size {
-				if frameBody[i] == 0 {
-					frame.shortContentDescription = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
-					frame.theActualText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
+		for i := 5; i < frameSize; i += frame.textEncoding.Size {
+			if frameBody[i] == 0 {
+				frame.description = lib.ToUTF8(frameBody[5:i], frame.textEncoding)
+				frame.pictureData = frameBody[i+frame.textEncoding.Size:]
 
-					break
-				}
+				break
 			}
+		}
 
-			frames = append(frames, frame)
-		case TypeiTunesCompilationFlag:
-			frame := ItunesCompilationFlagFrame{
-				frameBase:            frameBase,
-				encoding:             lib.Encodings[frameBody[0]],
-				isPartOfACompilation: len(frameBody) > 1 && string(frameBody[1]) == "1",
-			}
-			frames = append(frames, frame)
-		default:
-			frame := UnknownFrame{
-				frameBase: frameBase,
-				data:      frameBody,
+		return frame
+	case TypeUnsychronisedLyricsOrTextTranscription:
+		if frameSize < 4 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := UnsynchronisedLyricsOrTextTranscriptionFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+			language:     string(frameBody[1:4]),
+		}
+
+		for i := 4; i+frame.textEncoding.Size <= frameSize; i += frame.textEncoding.Size {
+			if frameBody[i] == 0 {
+				frame.contentDescriptor = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
+				frame.lyricsOrText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
+
+				break
 			}
-			frames = append(frames, frame)
 		}
-	}
 
-	tag := new(Tag)
-	tag.frames = frames
+		return frame
+	case TypeComments:
+		if frameSize < 4 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
 
-	return tag, nil
-}
+		frame := CommentsFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+			language:     string(frameBody[1:4]),
+		}
 
-func (tag Tag) Frames(ids ...string) []Frame {
-	if len(ids) == 0 {
-		return tag.frames
-	}
+		for i := 4; i+frame.textEncoding.Size <= frameSize; i += frame.textEncoding.Size {
+			if frameBody[i] == 0 {
+				frame.shortContentDescription = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
+				frame.theActualText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
 
-	frames := make([]Frame, 0)
-	for i := range tag.frames {
-		for j := range ids {
-			if tag.frames[i].ID() == ids[j] {
-				frames = append(frames, tag.frames[i])
+				break
 			}
 		}
-	}
 
-	return frames
-}
+		return frame
+	case TypeiTunesCompilationFlag:
+		frame := ItunesCompilationFlagFrame{
+			frameBase:            frameBase,
+			encoding:             lib.Encodings[frameBody[0]],
+			isPartOfACompilation: len(frameBody) > 1 && string(frameBody[1]) == "1",
+		}
 
-func (tag Tag) Title() string {
-	frames := tag.Frames("TT2")
-	if len(frames) > 0 {
-		frame, ok := frames[0].(TextInformationFrame)
-		if ok {
-			return frame.Text()
+		return frame
+	case TypeGeneralEncapsulatedObject:
+		frame := GeneralEncapsulatedObjectFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
 		}
-	}
 
-	return ""
-}
+		mimeType, rest := lib.SplitTerminated(frameBody[1:], lib.Encodings[0])
+		filename, rest := lib.SplitTerminated(rest, frame.textEncoding)
+		description, rest := lib.SplitTerminated(rest, frame.textEncoding)
 
-func (tag Tag) Artists() []string {
-	artists := make([]string, 0)
-	frames := tag.Frames("TP1")
+		frame.mimeType = string(mimeType)
+		frame.filename = lib.ToUTF8(filename, frame.textEncoding)
+		frame.contentDescription = lib.ToUTF8(description, frame.textEncoding)
+		frame.encapsulatedObject = rest
 
-	if len(frames) > 0 {
-		for i := range frames {
-			frame, ok := frames[i].(TextInformationFrame)
-			if ok {
-				artists = append(artists, strings.Split(frame.Text(), "/")...)
-			}
+		return frame
+	case TypePlayCounter:
+		frame := PlayCounterFrame{
+			frameBase: frameBase,
+			counter:   lib.ByteToInt(frameBody),
 		}
-	}
-
-	return artists
-}
 
-func (tag Tag) Album() string {
-	frames := tag.Frames("TAL")
-	if len(frames) > 0 {
-		frame, ok := frames[0].(TextInformationFrame)
-		if ok {
-			return frame.Text()
+		return frame
+	case TypePopularimeter:
+		email, rest := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := PopularimeterFrame{
+			frameBase:   frameBase,
+			emailToUser: string(email),
 		}
-	}
 
-	return ""
-}
+		if len(rest) > 0 {
+			frame.rating = rest[0]
+			frame.counter = lib.ByteToInt(rest[1:])
+		}
 
-func (tag Tag) AlbumArtists() []string {
-	albumArtists := make([]string, 0)
-	frames := tag.Frames("TP2")
+		return frame
+	case TypeUniqueFileIdentifier:
+		owner, identifier := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := UniqueFileIdentifierFrame{
+			frameBase:       frameBase,
+			ownerIdentifier: string(owner),
+			identifier:      identifier,
+		}
 
-	if len(frames) > 0 {
-		for i := range frames {
-			frame, ok := frames[i].(TextInformationFrame)
-			if ok {
-				albumArtists = append(albumArtists, strings.Split(frame.Text(), "/")...)
-			}
+		return frame
+	case TypeInvolvedPeopleList:
+		frame := InvolvedPeopleListFrame{
+			frameBase:  frameBase,
+			encoding:   lib.Encodings[frameBody[0]],
+			peopleList: lib.SplitStrings(frameBody[1:], lib.Encodings[frameBody[0]]),
 		}
-	}
 
-	return albumArtists
-}
+		return frame
+	case TypeMusicCDIdentifier:
+		frame := MusicCDIdentifierFrame{
+			frameBase: frameBase,
+			cdTOC:     frameBody,
+		}
 
-func (tag Tag) Year() string {
-	frames := tag.Frames("TYE")
-	if len(frames) > 0 {
-		frame, ok := frames[0].(TextInformationFrame)
-		if ok {
-			return frame.Text()
+		return frame
+	case TypeEventTimingCodes:
+		frame := EventTimingCodesFrame{
+			frameBase:       frameBase,
+			timeStampFormat: TimeStampFormat(frameBody[0]),
+			events:          make([]Event, 0),
 		}
-	}
 
-	return ""
-}
+		for i := 1; i+5 <= frameSize; i += 5 {
+			frame.events = append(frame.events, Event{
+				Type:      EventType(frameBody[i]),
+				TimeStamp: lib.ByteToInt(frameBody[i+1 : i+5]),
+			})
+		}
 
-func (tag Tag) TrackNumberAndPosition() (int, int) {
-	frames := tag.Frames("TRK")
-	trk, pos := 0, 0
+		return frame
+	case TypeSyncedTempoCodes:
+		frame := SyncedTempoCodesFrame{
+			frameBase:       frameBase,
+			timeStampFormat: TimeStampFormat(frameBody[0]),
+			tempos:          make([]Tempo, 0),
+		}
 
-	if len(frames) > 0 {
-		frame, ok := frames[0].(TextInformationFrame)
-		if ok {
-			t := strings.Split(frame.Text(), "/")
+		for i := 1; i < frameSize; {
+			bpm := int(frameBody[i])
+			i++
 
-			if len(t) > 0 {
-				trk, _ = strconv.Atoi(t[0])
+			if bpm == 0xff && i < frameSize {
+				bpm += int(frameBody[i])
+				i++
 			}
 
-			if len(t) > 1 {
-				pos, _ = strconv.Atoi(t[1])
+			if i+4 > frameSize {
+				break
 			}
-		}
-	}
 
-	return trk, pos
-}
+			frame.tempos = append(frame.tempos, Tempo{
+				BPM:       bpm,
+				TimeStamp: lib.ByteToInt(frameBody[i : i+4]),
+			})
+			i += 4
+		}
 
-func (tag Tag) AttachedPictures() []AttachedPictureFrame {
-	frames := tag.Frames("PIC")
-	pics := make([]AttachedPictureFrame, 0)
+		return frame
+	case TypeMPEGLocationLookupTable:
+		if frameSize < 10 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
 
-	for i := range frames {
-		if pic, ok := frames[i].(AttachedPictureFrame); ok {
-			pics = append(pics, pic)
+		frame := MPEGLocationLookupTableFrame{
+			frameBase:                    frameBase,
+			mpegFramesBetweenReference:   lib.ByteToInt(frameBody[0:2]),
+			bytesBetweenReference:        lib.ByteToInt(frameBody[2:5]),
+			millisecondsBetweenReference: lib.ByteToInt(frameBody[5:8]),
+			bitsForBytesDeviation:        int(frameBody[8]),
+			bitsForMillisecondsDeviation: int(frameBody[9]),
+			references:                   make([]MPEGLocationLookupTableReference, 0),
 		}
-	}
 
-	return pics
-}
+		table := frameBody[10:]
+		referenceBits := frame.bitsForBytesDeviation + frame.bitsForMillisecondsDeviation
 
-func genreProcess(s string) string {
-	idxs := regexp.MustCompile("[(][0-9]+[)]").FindStringIndex(s)
-	if len(s[idxs[1]:]) > 0 && s[idxs[1]] != 0 {
-		return s[idxs[1]:]
-	}
+		for i := 0; referenceBits > 0 && i+referenceBits <= len(table)*8; i += referenceBits {
+			frame.references = append(frame.references, MPEGLocationLookupTableReference{
+				BytesDeviation:        lib.Bits(table, i, frame.bitsForBytesDeviation),
+				MillisecondsDeviation: lib.Bits(table, i+frame.bitsForBytesDeviation, frame.bitsForMillisecondsDeviation),
+			})
+		}
 
-	id, err := strconv.Atoi(strings.Trim(s[idxs[0]:idxs[1]], "()"))
-	if err == nil {
-		if len(v1.Genres) > id {
-			return v1.Genres[id]
+		return frame
+	case TypeUserDefinedURLLink:
+		frame := UserDefinedURLLinkFrame{
+			frameBase: frameBase,
+			encoding:  lib.Encodings[frameBody[0]],
 		}
-	}
 
-	return ""
-}
+		description, url := lib.SplitTerminated(frameBody[1:], frame.encoding)
+		frame.description = lib.ToUTF8(description, frame.encoding)
+		frame.url = string(url)
 
-func (tag Tag) Genres() []string {
-	genres := make([]string, 0)
-	re := regexp.MustCompile("[(][0-9]+[)]")
+		return frame
+	case TypeSynchronisedLyricsOrText:
+		if frameSize < 6 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
 
-	frames := tag.Frames("TCO")
-	for i := range frames {
-		if tif, ok := frames[i].(TextInformationFrame); ok {
-			txt := tif.Text()
-			idxs := re.FindAllStringIndex(txt, -1)
-			old := 0
+		frame := SynchronisedLyricsOrTextFrame{
+			frameBase:       frameBase,
+			textEncoding:    lib.Encodings[frameBody[0]],
+			language:        string(frameBody[1:4]),
+			timeStampFormat: TimeStampFormat(frameBody[4]),
+			contentType:     ContentType(frameBody[5]),
+			texts:           make([]SynchronisedText, 0),
+		}
 
-			for _, idx := range idxs {
-				if old == idx[0] {
-					continue
-				}
+		descriptor, rest := lib.SplitTerminated(frameBody[6:], frame.textEncoding)
+		frame.contentDescriptor = lib.ToUTF8(descriptor, frame.textEncoding)
 
-				if genre := genreProcess(txt[old:idx[0]]); genre != "" {
-					genres = append(genres, genre)
-				}
+		for len(rest) > 0 {
+			var text []byte
 
-				old = idx[0]
+			text, rest = lib.SplitTerminated(rest, frame.textEncoding)
+			if len(rest) < 4 {
+				break
 			}
 
-			if genre := genreProcess(txt[old:]); genre != "" {
-				genres = append(genres, genre)
-			}
+			frame.texts = append(frame.texts, SynchronisedText{
+				Text:      lib.ToUTF8(text, frame.textEncoding),
+				TimeStamp: lib.ByteToInt(rest[:4]),
+			})
+			rest = rest[4:]
 		}
-	}
 
-	return genres
+		return frame
+	case TypeRelativeVolumeAdjustment:
+		if frameSize < 2 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := RelativeVolumeAdjustmentFrame{
+			frameBase:         frameBase,
+			bitsUsedForVolume: int(frameBody[1]),
+		}
+
+		volumeSize := (frame.bitsUsedForVolume + 7) / 8
+		if volumeSize == 0 || frameSize < 2+4*volumeSize {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		volumes := frameBody[2:]
+		frame.volumeChangeRight = lib.ByteToInt(volumes[0:volumeSize])
+		frame.volumeChangeLeft = lib.ByteToInt(volumes[volumeSize : 2*volumeSize])
+		frame.peakVolumeRight = lib.ByteToInt(volumes[2*volumeSize : 3*volumeSize])
+		frame.peakVolumeLeft = lib.ByteToInt(volumes[3*volumeSize : 4*volumeSize])
+
+		if frameBody[0]&1 == 0 {
+			frame.volumeChangeRight = -frame.volumeChangeRight
+		}
+
+		if frameBody[0]&2 == 0 {
+			frame.volumeChangeLeft = -frame.volumeChangeLeft
+		}
+
+		return frame
+	case TypeEqualisation:
+		frame := EqualisationFrame{
+			frameBase:      frameBase,
+			adjustmentBits: int(frameBody[0]),
+			bands:          make([]EqualisationBand, 0),
+		}
+
+		adjustmentSize := (frame.adjustmentBits + 7) / 8
+		for i := 1; adjustmentSize > 0 && i+2+adjustmentSize <= frameSize; i += 2 + adjustmentSize {
+			frame.bands = append(frame.bands, EqualisationBand{
+				Increment:  frameBody[i]&0x80 == 0x80,
+				Frequency:  lib.ByteToInt(frameBody[i:i+2]) & 0x7fff,
+				Adjustment: lib.ByteToInt(frameBody[i+2 : i+2+adjustmentSize]),
+			})
+		}
+
+		return frame
+	case TypeReverb:
+		if frameSize < 12 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := ReverbFrame{
+			frameBase:            frameBase,
+			reverbLeft:           lib.ByteToInt(frameBody[0:2]),
+			reverbRight:          lib.ByteToInt(frameBody[2:4]),
+			reverbBouncesLeft:    frameBody[4],
+			reverbBouncesRight:   frameBody[5],
+			feedbackLeftToLeft:   frameBody[6],
+			feedbackLeftToRight:  frameBody[7],
+			feedbackRightToRight: frameBody[8],
+			feedbackRightToLeft:  frameBody[9],
+			premixLeftToRight:    frameBody[10],
+			premixRightToLeft:    frameBody[11],
+		}
+
+		return frame
+	case TypeRecommendedBufferSize:
+		if frameSize < 4 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := RecommendedBufferSizeFrame{
+			frameBase:    frameBase,
+			bufferSize:   lib.ByteToInt(frameBody[0:3]),
+			embeddedInfo: frameBody[3]&1 == 1,
+		}
+
+		if frameSize >= 8 {
+			frame.offsetToNextTag = lib.ByteToInt(frameBody[4:8])
+		}
+
+		return frame
+	case TypeEncryptedMetaFrame:
+		owner, rest := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		content, data := lib.SplitTerminated(rest, lib.Encodings[0])
+		frame := EncryptedMetaFrame{
+			frameBase:          frameBase,
+			ownerIdentifier:    string(owner),
+			content:            string(content),
+			encryptedDatablock: data,
+		}
+
+		return frame
+	case TypeAudioEncryption:
+		owner, rest := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := AudioEncryptionFrame{
+			frameBase:       frameBase,
+			ownerIdentifier: string(owner),
+		}
+
+		if len(rest) >= 4 {
+			frame.previewStart = lib.ByteToInt(rest[0:2])
+			frame.previewLength = lib.ByteToInt(rest[2:4])
+			frame.encryptionInfo = rest[4:]
+		}
+
+		return frame
+	case TypeLinkedInformation:
+		if frameSize < 4 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		url, idData := lib.SplitTerminated(frameBody[3:], lib.Encodings[0])
+
+		return LinkedInformationFrame{
+			frameBase:       frameBase,
+			frameIdentifier: string(frameBody[:3]),
+			url:             string(url),
+			idData:          idData,
+		}
+	default:
+		frame := UnknownFrame{
+			frameBase: frameBase,
+			data:      frameBody,
+		}
+
+		return frame
+	}
+}
+
+func (tag Tag) Frames(ids ...string) []Frame {
+	if len(ids) == 0 {
+		return tag.frames
+	}
+
+	frames := make([]Frame, 0)
+	for i := range tag.frames {
+		for j := range ids {
+			if tag.frames[i].ID() == ids[j] {
+				frames = append(frames, tag.frames[i])
+			}
+		}
+	}
+
+	return frames
+}
+
+// AddFrame will add frame to the tag, the file is not changed.
+func (tag *Tag) AddFrame(frame Frame) {
+	tag.frames = append(tag.frames, frame)
+}
+
+// RemoveFrames will remove frames which match returns true for, the file is not changed.
+func (tag *Tag) RemoveFrames(match func(Frame) bool) {
+	frames := make([]Frame, 0, len(tag.frames))
+
+	for i := range tag.frames {
+		if !match(tag.frames[i]) {
+			frames = append(frames, tag.frames[i])
+		}
+	}
+
+	tag.frames = frames
+}
+
+// Texts will return value of the first text information frame with given id.
+func (tag Tag) Texts(id string) []string {
+	if value := strings.TrimRight(tag.text(id), "\x00"); value != "" {
+		return []string{value}
+	}
+
+	return []string{}
+}
+
+// joinedText will return text of text information frame with given id as one string, trailing nulls are trimmed.
+func (tag Tag) joinedText(id string) string {
+	return strings.Join(tag.Texts(id), "/")
+}
+
+// SetText will replace text information frames with given id, without values frames are removed.
+// The new frame is decoded like a read frame, so text based frames like compilation flag have their own type.
+func (tag *Tag) SetText(id string, values ...string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		return frame.ID() == id
+	})
+
+	if len(values) > 0 {
+		frame := NewTextInformationFrame(id, values...)
+		tag.AddFrame(newFrame(frame.frameBase, frame.Body()))
+	}
+}
+
+// SetUserDefinedTextInformation will replace user defined text information with given description,
+// description is case-insensitive and without values frames are removed.
+func (tag *Tag) SetUserDefinedTextInformation(description string, values ...string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(UserDefinedTextInformationFrame)
+
+		return ok && strings.EqualFold(f.Description(), description)
+	})
+
+	if len(values) > 0 {
+		tag.AddFrame(NewUserDefinedTextInformationFrame(description, values...))
+	}
+}
+
+// SetUniqueFileIdentifier will replace unique file identifier of owner, without identifier frames are removed.
+func (tag *Tag) SetUniqueFileIdentifier(ownerIdentifier string, identifier []byte) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(UniqueFileIdentifierFrame)
+
+		return ok && f.OwnerIdentifier() == ownerIdentifier
+	})
+
+	if len(identifier) > 0 {
+		tag.AddFrame(NewUniqueFileIdentifierFrame(ownerIdentifier, identifier))
+	}
+}
+
+// text will return text of the first text information frame with given id.
+func (tag Tag) text(id string) string {
+	frames := tag.Frames(id)
+	if len(frames) > 0 {
+		frame, ok := frames[0].(TextInformationFrame)
+		if ok {
+			return frame.Text()
+		}
+	}
+
+	return ""
+}
+
+// timestamp will return the first timestamp of text information frame with given id.
+func (tag Tag) timestamp(id string) lib.Timestamp {
+	ts, _ := lib.ParseTimestamp(strings.SplitN(tag.text(id), "\x00", 2)[0])
+
+	return ts
+}
+
+// milliseconds will return duration of text information frame with given id, which is in milliseconds.
+func (tag Tag) milliseconds(id string) time.Duration {
+	ms, _ := strconv.Atoi(strings.TrimSpace(strings.TrimRight(tag.text(id), "\x00")))
+
+	return time.Duration(ms) * time.Millisecond
+}
+
+func (tag Tag) Title() string {
+	frames := tag.Frames("TT2")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(TextInformationFrame)
+		if ok {
+			return frame.Text()
+		}
+	}
+
+	return ""
+}
+
+// Artist will return lead artist(s) as stored, without splitting multiple artists.
+func (tag Tag) Artist() string {
+	return strings.TrimRight(tag.text("TP1"), "\x00")
+}
+
+// AlbumArtist will return band or album artist(s) as stored, without splitting multiple artists.
+func (tag Tag) AlbumArtist() string {
+	return strings.TrimRight(tag.text("TP2"), "\x00")
+}
+
+func (tag Tag) Artists() []string {
+	artists := make([]string, 0)
+	frames := tag.Frames("TP1")
+
+	if len(frames) > 0 {
+		for i := range frames {
+			frame, ok := frames[i].(TextInformationFrame)
+			if ok {
+				artists = append(artists, strings.Split(frame.Text(), "/")...)
+			}
+		}
+	}
+
+	return artists
+}
+
+func (tag Tag) Album() string {
+	frames := tag.Frames("TAL")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(TextInformationFrame)
+		if ok {
+			return frame.Text()
+		}
+	}
+
+	return ""
+}
+
+func (tag Tag) AlbumArtists() []string {
+	albumArtists := make([]string, 0)
+	frames := tag.Frames("TP2")
+
+	if len(frames) > 0 {
+		for i := range frames {
+			frame, ok := frames[i].(TextInformationFrame)
+			if ok {
+				albumArtists = append(albumArtists, strings.Split(frame.Text(), "/")...)
+			}
+		}
+	}
+
+	return albumArtists
+}
+
+func (tag Tag) Year() string {
+	frames := tag.Frames("TYE")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(TextInformationFrame)
+		if ok {
+			return frame.Text()
+		}
+	}
+
+	return ""
+}
+
+// RecordingTime will return recording time merged from year (TYE), date (TDA) and time (TIM).
+// Recording dates (TRD) is used when there is no year.
+func (tag Tag) RecordingTime() lib.Timestamp {
+	ts, err := lib.ParseSplitTimestamp(tag.text("TYE"), tag.text("TDA"), tag.text("TIM"))
+	if err != nil {
+		return tag.timestamp("TRD")
+	}
+
+	return ts
+}
+
+// SetRecordingTime will replace recording time, year (TYE), date (TDA) and time (TIM) are written up to
+// precision of timestamp and recording dates (TRD) is removed. A zero timestamp removes all of them.
+func (tag *Tag) SetRecordingTime(ts lib.Timestamp) {
+	year, date, hourMinute := lib.SplitTimestamp(ts)
+
+	ids, values := []string{"TYE", "TDA", "TIM", "TRD"}, []string{year, date, hourMinute, ""}
+
+	for i := range ids {
+		if values[i] != "" {
+			tag.SetText(ids[i], values[i])
+		} else {
+			tag.SetText(ids[i])
+		}
+	}
+}
+
+// OriginalReleaseTime will return original release year (TOR).
+func (tag Tag) OriginalReleaseTime() lib.Timestamp {
+	return tag.timestamp("TOR")
+}
+
+// TrackNumberAndPosition will return track number and total tracks (TRK), ok is false without a track number.
+func (tag Tag) TrackNumberAndPosition() (int, int, bool) {
+	return lib.ParseNumberAndTotal(tag.text("TRK"))
+}
+
+// IsCompilation will return iTunes compilation flag.
+func (tag Tag) IsCompilation() bool {
+	frames := tag.Frames("TCP")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(ItunesCompilationFlagFrame)
+		if ok {
+			return frame.IsPartOfACompilation()
+		}
+	}
+
+	return false
+}
+
+// Grouping will return content group description, which iTunes uses as grouping of ID3v2.2 tags.
+func (tag Tag) Grouping() string {
+	frames := tag.Frames("TT1")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(TextInformationFrame)
+		if ok {
+			return frame.Text()
+		}
+	}
+
+	return ""
+}
+
+// DiscNumberAndTotal will return disc number and total discs of a set (TPA), ok is false without a disc number.
+func (tag Tag) DiscNumberAndTotal() (int, int, bool) {
+	return lib.ParseNumberAndTotal(tag.text("TPA"))
+}
+
+func (tag Tag) Subtitle() string {
+	return tag.joinedText("TT3")
+}
+
+func (tag Tag) Composer() string {
+	return tag.joinedText("TCM")
+}
+
+func (tag Tag) Lyricist() string {
+	return tag.joinedText("TXT")
+}
+
+func (tag Tag) Conductor() string {
+	return tag.joinedText("TP3")
+}
+
+// RemixedBy will return who interpreted, remixed, or otherwise modified the track (TP4).
+func (tag Tag) RemixedBy() string {
+	return tag.joinedText("TP4")
+}
+
+// InitialKey will return musical key in which the sound starts, like "Dbm" (TKE).
+func (tag Tag) InitialKey() string {
+	return tag.joinedText("TKE")
+}
+
+// ISRC will return international standard recording code (TRC).
+func (tag Tag) ISRC() string {
+	return tag.joinedText("TRC")
+}
+
+func (tag Tag) Publisher() string {
+	return tag.joinedText("TPB")
+}
+
+// Copyright will return copyright message, like "2004 Label" (TCR).
+func (tag Tag) Copyright() string {
+	return tag.joinedText("TCR")
+}
+
+func (tag Tag) EncodedBy() string {
+	return tag.joinedText("TEN")
+}
+
+// EncoderSettings will return software, hardware and settings used for encoding (TSS).
+func (tag Tag) EncoderSettings() string {
+	return tag.joinedText("TSS")
+}
+
+// Language will return ISO 639-2 language code(s) of the audio (TLA).
+func (tag Tag) Language() string {
+	return tag.joinedText("TLA")
+}
+
+func (tag Tag) MediaType() string {
+	return tag.joinedText("TMT")
+}
+
+func (tag Tag) FileType() string {
+	return tag.joinedText("TFT")
+}
+
+func (tag Tag) OriginalAlbum() string {
+	return tag.joinedText("TOT")
+}
+
+func (tag Tag) OriginalArtist() string {
+	return tag.joinedText("TOA")
+}
+
+func (tag Tag) OriginalLyricist() string {
+	return tag.joinedText("TOL")
+}
+
+func (tag Tag) OriginalFilename() string {
+	return tag.joinedText("TOF")
+}
+
+// BPM will return beats per minute, rounded to an integer.
+func (tag Tag) BPM() int {
+	bpm, _ := strconv.ParseFloat(strings.TrimSpace(strings.TrimRight(tag.text("TBP"), "\x00")), 64)
+
+	return int(math.Round(bpm))
+}
+
+// Length will return length of audio (TLE).
+func (tag Tag) Length() time.Duration {
+	return tag.milliseconds("TLE")
+}
+
+// PlaylistDelay will return silence between the end of previous track and this track (TDY).
+func (tag Tag) PlaylistDelay() time.Duration {
+	return tag.milliseconds("TDY")
+}
+
+// Lyrics will return the first unsynchronised lyrics without content descriptor, or the first lyrics.
+func (tag Tag) Lyrics() string {
+	lyrics := ""
+	frames := tag.Frames("ULT")
+
+	for i := range frames {
+		frame, ok := frames[i].(UnsynchronisedLyricsOrTextTranscriptionFrame)
+		if !ok {
+			continue
+		}
+
+		if frame.ContentDescriptor() == "" {
+			return frame.LyricsOrText()
+		}
+
+		if lyrics == "" {
+			lyrics = frame.LyricsOrText()
+		}
+	}
+
+	return lyrics
+}
+
+// SetComment will replace comments without short content description, without text they are removed.
+func (tag *Tag) SetComment(text string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(CommentsFrame)
+
+		return ok && f.ShortContentDescription() == ""
+	})
+
+	if text != "" {
+		tag.AddFrame(NewCommentsFrame("XXX", "", text))
+	}
+}
+
+// SetLyrics will replace unsynchronised lyrics without content descriptor, without lyrics they are removed.
+func (tag *Tag) SetLyrics(lyrics string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(UnsynchronisedLyricsOrTextTranscriptionFrame)
+
+		return ok && f.ContentDescriptor() == ""
+	})
+
+	if lyrics != "" {
+		tag.AddFrame(NewUnsynchronisedLyricsOrTextTranscriptionFrame("XXX", "", lyrics))
+	}
+}
+
+// Comment will return the first comment without short content description, or the first comment.
+func (tag Tag) Comment() string {
+	comment := ""
+	frames := tag.Frames("COM")
+
+	for i := range frames {
+		frame, ok := frames[i].(CommentsFrame)
+		if !ok {
+			continue
+		}
+
+		if frame.ShortContentDescription() == "" {
+			return frame.TheActualText()
+		}
+
+		if comment == "" {
+			comment = frame.TheActualText()
+		}
+	}
+
+	return comment
+}
+
+// TitleSortOrder will return iTunes title sort order.
+func (tag Tag) TitleSortOrder() string {
+	return tag.joinedText("TST")
+}
+
+// ArtistSortOrder will return iTunes performer sort order.
+func (tag Tag) ArtistSortOrder() string {
+	return tag.joinedText("TSP")
+}
+
+// AlbumSortOrder will return iTunes album sort order.
+func (tag Tag) AlbumSortOrder() string {
+	return tag.joinedText("TSA")
+}
+
+// AlbumArtistSortOrder will return iTunes album artist sort order.
+func (tag Tag) AlbumArtistSortOrder() string {
+	return tag.joinedText("TS2")
+}
+
+// ComposerSortOrder will return iTunes composer sort order.
+func (tag Tag) ComposerSortOrder() string {
+	return tag.joinedText("TSC")
+}
+
+func (tag Tag) AttachedPictures() []AttachedPictureFrame {
+	frames := tag.Frames("PIC")
+	pics := make([]AttachedPictureFrame, 0)
+
+	for i := range frames {
+		if pic, ok := frames[i].(AttachedPictureFrame); ok {
+			pics = append(pics, pic)
+		}
+	}
+
+	return pics
+}
+
+func (tag Tag) GeneralEncapsulatedObjects() []GeneralEncapsulatedObjectFrame {
+	frames := tag.Frames("GEO")
+	objects := make([]GeneralEncapsulatedObjectFrame, 0)
+
+	for i := range frames {
+		if object, ok := frames[i].(GeneralEncapsulatedObjectFrame); ok {
+			objects = append(objects, object)
+		}
+	}
+
+	return objects
+}
+
+func (tag Tag) PlayCounter() int {
+	frames := tag.Frames("CNT")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(PlayCounterFrame)
+		if ok {
+			return frame.Counter()
+		}
+	}
+
+	return 0
+}
+
+func (tag Tag) Popularimeters() []PopularimeterFrame {
+	frames := tag.Frames("POP")
+	popularimeters := make([]PopularimeterFrame, 0)
+
+	for i := range frames {
+		if popularimeter, ok := frames[i].(PopularimeterFrame); ok {
+			popularimeters = append(popularimeters, popularimeter)
+		}
+	}
+
+	return popularimeters
+}
+
+func (tag Tag) UniqueFileIdentifier(owner string) []byte {
+	frames := tag.Frames("UFI")
+	for i := range frames {
+		frame, ok := frames[i].(UniqueFileIdentifierFrame)
+		if ok && frame.OwnerIdentifier() == owner {
+			return frame.Identifier()
+		}
+	}
+
+	return nil
+}
+
+// UserDefinedTextInformation will return value of user defined text with given description, description is case-insensitive.
+func (tag Tag) UserDefinedTextInformation(description string) string {
+	frames := tag.Frames("TXX")
+	for i := range frames {
+		frame, ok := frames[i].(UserDefinedTextInformationFrame)
+		if ok && strings.EqualFold(frame.Description(), description) {
+			return frame.Value()
+		}
+	}
+
+	return ""
+}
+
+func (tag Tag) InvolvedPeople() []InvolvedPerson {
+	people := make([]InvolvedPerson, 0)
+	frames := tag.Frames("IPL")
+
+	for i := range frames {
+		if frame, ok := frames[i].(InvolvedPeopleListFrame); ok {
+			people = append(people, frame.People()...)
+		}
+	}
+
+	return people
+}
+
+func (tag Tag) MusicCDIdentifier() []byte {
+	frames := tag.Frames("MCI")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(MusicCDIdentifierFrame)
+		if ok {
+			return frame.CDTOC()
+		}
+	}
+
+	return nil
+}
+
+func (tag Tag) EventTimingCodes() []EventTimingCodesFrame {
+	frames := tag.Frames("ETC")
+	res := make([]EventTimingCodesFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(EventTimingCodesFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) SyncedTempoCodes() []SyncedTempoCodesFrame {
+	frames := tag.Frames("STC")
+	res := make([]SyncedTempoCodesFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(SyncedTempoCodesFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+// SeekOffset will return file offset of d using MPEG location lookup table, tag is expected at the start of file.
+func (tag Tag) SeekOffset(d time.Duration) (int, bool) {
+	frames := tag.Frames("MLL")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(MPEGLocationLookupTableFrame)
+		if ok {
+			return HeaderSize + tag.size + frame.Seek(d), true
+		}
+	}
+
+	return 0, false
+}
+
+func (tag Tag) SynchronisedLyrics() []SynchronisedLyricsOrTextFrame {
+	frames := tag.Frames("SLT")
+	res := make([]SynchronisedLyricsOrTextFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(SynchronisedLyricsOrTextFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) RelativeVolumeAdjustments() []RelativeVolumeAdjustmentFrame {
+	frames := tag.Frames("RVA")
+	res := make([]RelativeVolumeAdjustmentFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(RelativeVolumeAdjustmentFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) Equalisations() []EqualisationFrame {
+	frames := tag.Frames("EQU")
+	res := make([]EqualisationFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(EqualisationFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) Reverbs() []ReverbFrame {
+	frames := tag.Frames("REV")
+	res := make([]ReverbFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(ReverbFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) RecommendedBufferSizes() []RecommendedBufferSizeFrame {
+	frames := tag.Frames("BUF")
+	res := make([]RecommendedBufferSizeFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(RecommendedBufferSizeFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) EncryptedMetaFrames() []EncryptedMetaFrame {
+	frames := tag.Frames("CRM")
+	res := make([]EncryptedMetaFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(EncryptedMetaFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) AudioEncryptions() []AudioEncryptionFrame {
+	frames := tag.Frames("CRA")
+	res := make([]AudioEncryptionFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(AudioEncryptionFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) LinkedInformations() []LinkedInformationFrame {
+	frames := tag.Frames("LNK")
+	res := make([]LinkedInformationFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(LinkedInformationFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+// ResolveLinks will replace linked information frames by the frames they refer to, opening linked files with r.
+// Links to frames which are not found in the linked file, or to files without a tag of this version,
+// stay in the tag.
+func (tag *Tag) ResolveLinks(r lib.LinkResolver) error {
+	frames := make([]Frame, 0, len(tag.frames))
+
+	for i := range tag.frames {
+		link, ok := tag.frames[i].(LinkedInformationFrame)
+		if !ok {
+			frames = append(frames, tag.frames[i])
+
+			continue
+		}
+
+		linked, err := resolveLink(r, link)
+		if err != nil {
+			return fmt.Errorf("error on resolve link '%s': %w", link.URL(), err)
+		}
+
+		if len(linked) == 0 {
+			frames = append(frames, link)
+
+			continue
+		}
+
+		frames = append(frames, linked...)
+	}
+
+	tag.frames = frames
+
+	return nil
+}
+
+func resolveLink(r lib.LinkResolver, link LinkedInformationFrame) ([]Frame, error) {
+	f, err := r.Open(link.URL())
+	if err != nil {
+		return nil, fmt.Errorf("error on open: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	tag, err := New(f)
+	if errors.Is(err, ErrTagNotFound) {
+		// Files without a tag of this version have no frames which can replace the link.
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error on new: %w", err)
+	}
+
+	idData := strings.TrimRight(string(link.IDData()), "\x00")
+	res := make([]Frame, 0)
+
+	for _, frame := range tag.Frames(link.FrameIdentifier()) {
+		if _, ok := frame.(LinkedInformationFrame); ok {
+			continue
+		}
+
+		if linkMatches(frame, idData) {
+			res = append(res, frame)
+		}
+	}
+
+	return res, nil
+}
+
+// linkMatches reports whether frame is identified by additional id data of a link.
+func linkMatches(frame Frame, idData string) bool {
+	if idData == "" {
+		return true
+	}
+
+	switch f := frame.(type) {
+	case interface{ Description() string }:
+		return f.Description() == idData
+	case interface{ Language() string }:
+		return strings.HasPrefix(idData, f.Language())
+	}
+
+	return true
+}
+
+// Genres will return genres of content type, numeric references and "RX" and "CR" are resolved to names.
+func (tag Tag) Genres() []string {
+	genres := make([]string, 0)
+
+	frames := tag.Frames("TCO")
+	for i := range frames {
+		if tif, ok := frames[i].(TextInformationFrame); ok {
+			genres = append(genres, v1.ParseContentType(tif.Text())...)
+		}
+	}
+
+	return genres
+}
+
+// SetGenres will replace content type with genres, known genres are written as references like "(17)".
+func (tag *Tag) SetGenres(genres ...string) {
+	if contentType := v1.FormatContentType(genres); contentType != "" {
+		tag.SetText("TCO", contentType)
+	} else {
+		tag.SetText("TCO")
+	}
 }
@@ -0,0 +1,72 @@
+package v22
+
+import (
+	"bytes"
+	"testing"
+)
+
+type testFrame struct {
+	id   string
+	body []byte
+}
+
+func syncsafe(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7f), byte(n >> 14 & 0x7f), byte(n >> 7 & 0x7f), byte(n & 0x7f)}
+}
+
+// newTestTag will return tag of frames, the tag is followed by padding and audio data.
+func newTestTag(t *testing.T, frames ...testFrame) *Tag {
+	t.Helper()
+
+	b := make([]byte, 0)
+
+	for _, f := range frames {
+		b = append(b, f.id...)
+		b = append(b, byte(len(f.body)>>16), byte(len(f.body)>>8), byte(len(f.body)))
+		b = append(b, f.body...)
+	}
+
+	b = append(b, make([]byte, 16)...)
+	data := append([]byte{'I', 'D', '3', 2, 0, 0}, syncsafe(len(b))...)
+	data = append(data, b...)
+	data = append(data, make([]byte, 128)...)
+
+	tag, err := New(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("error on new tag: %v", err)
+	}
+
+	return tag
+}
+
+func TestNewInvalidEncoding(t *testing.T) {
+	for _, id := range []string{"GEO", "COM", "ULT", "IPL", "TCP", "SLT"} {
+		for _, body := range [][]byte{{4}, {4, 'a', 0, 'b'}, {255, 'a', 0, 'b', 0, 'c'}} {
+			tag := newTestTag(t, testFrame{id: id, body: body})
+
+			frames := tag.Frames(id)
+			if len(frames) != 1 {
+				t.Fatalf("Frames(%q) = %d frames, want 1", id, len(frames))
+			}
+
+			if _, ok := frames[0].(UnknownFrame); !ok {
+				t.Errorf("frame %q with encoding %d is %T, want UnknownFrame", id, body[0], frames[0])
+			}
+		}
+	}
+}
+
+func TestNewTruncatedCommentsAndLyrics(t *testing.T) {
+	for _, id := range []string{"COM", "ULT"} {
+		tag := newTestTag(t, testFrame{id: id, body: []byte{0, 'e', 'n'}})
+		if _, ok := tag.Frames(id)[0].(UnknownFrame); !ok {
+			t.Errorf("frame %q of 3 bytes is %T, want UnknownFrame", id, tag.Frames(id)[0])
+		}
+
+		// UTF-16 description terminated with a single byte at the end of the body.
+		tag = newTestTag(t, testFrame{id: id, body: []byte{1, 'e', 'n', 'g', 0}})
+		if _, ok := tag.Frames(id)[0].(UnknownFrame); ok {
+			t.Errorf("frame %q with truncated terminator is UnknownFrame", id)
+		}
+	}
+}
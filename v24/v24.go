@@ -1,16 +1,15 @@
 package v24
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"image"
-	"image/jpeg"
-	"image/png"
 	"io"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/xonyagar/id3/lib"
 	v1 "github.com/xonyagar/id3/v1"
@@ -22,6 +21,9 @@ const HeaderSize = 10
 // FrameHeaderSize is size of ID3v2.4 tag frame header.
 const FrameHeaderSize = 10
 
+// FooterSize is size of ID3v2.4 tag footer.
+const FooterSize = 10
+
 var ErrTagNotFound = errors.New("no id3v2.4.0 tag found")
 
 type FrameType int
@@ -51,13 +53,23 @@ const (
 	TypeEncryptedMetaFrame
 	TypeAudioEncryption
 	TypeLinkedInformation
+	TypePositionSynchronisation
+	TypeiTunesCompilationFlag
 
 	TypeTermOfUse
+	TypePrivate
+	TypeOwnership
+	TypeCommercial
+	TypeEncryptionMethodRegistration
+	TypeGroupIdentificationRegistration
+	TypeSignature
+	TypeAudioSeekPointIndex
 )
 
 type Frame interface {
 	ID() string
 	Size() int
+	GroupSymbol() (byte, bool)
 }
 
 type frameBase struct {
@@ -71,6 +83,9 @@ type frameBase struct {
 	flagEncryption            bool
 	flagUnsynchronisation     bool
 	flagDataLengthIndicator   bool
+	groupSymbol               byte
+	encryptionMethod          byte
+	dataLength                int
 }
 
 func (f frameBase) ID() string {
@@ -81,6 +96,39 @@ func (f frameBase) Size() int {
 	return f.size
 }
 
+// GroupSymbol will return group symbol of frame, if frame belongs to a group.
+func (f frameBase) GroupSymbol() (byte, bool) {
+	return f.groupSymbol, f.flagGroupingIdentity
+}
+
+// EncryptionMethod will return encryption method symbol of frame, if frame is encrypted.
+func (f frameBase) EncryptionMethod() (byte, bool) {
+	return f.encryptionMethod, f.flagEncryption
+}
+
+// decode will reverse unsynchronisation and compression of frame body.
+func (f frameBase) decode(body []byte) ([]byte, error) {
+	return f.inflate(f.deunsynchronise(body))
+}
+
+// deunsynchronise will reverse unsynchronisation of frame body, which is applied after encryption.
+func (f frameBase) deunsynchronise(body []byte) []byte {
+	if f.flagUnsynchronisation {
+		return lib.Deunsynchronise(body)
+	}
+
+	return body
+}
+
+// inflate will reverse compression of frame body, which is applied before encryption.
+func (f frameBase) inflate(body []byte) ([]byte, error) {
+	if f.flagCompression {
+		return lib.Inflate(body)
+	}
+
+	return body, nil
+}
+
 type UnknownFrame struct {
 	frameBase
 	data []byte
@@ -104,6 +152,23 @@ func (f UniqueFileIdentifierFrame) Identifier() []byte {
 	return f.identifier
 }
 
+func NewUniqueFileIdentifierFrame(ownerIdentifier string, identifier []byte) UniqueFileIdentifierFrame {
+	frame := UniqueFileIdentifierFrame{
+		frameBase:       frameBase{id: "UFID"},
+		ownerIdentifier: ownerIdentifier,
+		identifier:      identifier,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f UniqueFileIdentifierFrame) Body() []byte {
+	body := append([]byte(f.ownerIdentifier), 0)
+
+	return append(body, f.identifier...)
+}
+
 type TextInformationFrame struct {
 	frameBase
 	encoding lib.Encoding
@@ -114,6 +179,22 @@ func (f TextInformationFrame) Text() string {
 	return f.text
 }
 
+// NewTextInformationFrame will return text information frame, multiple values are separated by null.
+func NewTextInformationFrame(id string, values ...string) TextInformationFrame {
+	frame := TextInformationFrame{
+		frameBase: frameBase{id: id},
+		encoding:  lib.Encodings[3],
+		text:      strings.Join(values, "\x00"),
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f TextInformationFrame) Body() []byte {
+	return append([]byte{f.encoding.Byte()}, lib.FromUTF8(f.text, f.encoding)...)
+}
+
 type UserDefinedTextInformationFrame struct {
 	frameBase
 	encoding    lib.Encoding
@@ -129,6 +210,27 @@ func (f UserDefinedTextInformationFrame) Value() string {
 	return f.value
 }
 
+// NewUserDefinedTextInformationFrame will return user defined text information frame,
+// multiple values are separated by null.
+func NewUserDefinedTextInformationFrame(description string, values ...string) UserDefinedTextInformationFrame {
+	frame := UserDefinedTextInformationFrame{
+		frameBase:   frameBase{id: "TXXX"},
+		encoding:    lib.Encodings[3],
+		description: description,
+		value:       strings.Join(values, "\x00"),
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f UserDefinedTextInformationFrame) Body() []byte {
+	body := append([]byte{f.encoding.Byte()}, lib.FromUTF8(f.description, f.encoding)...)
+	body = append(body, f.encoding.Termination()...)
+
+	return append(body, lib.FromUTF8(f.value, f.encoding)...)
+}
+
 type TermOfUseFrame struct {
 	frameBase
 	textEncoding  lib.Encoding
@@ -144,6 +246,12 @@ func (f TermOfUseFrame) TheActualText() string {
 	return f.theActualText
 }
 
+// InvolvedPerson is an involvement and its involvee, like "producer" and "John Doe".
+type InvolvedPerson struct {
+	Involvement string
+	Involvee    string
+}
+
 type InvolvedPeopleListFrame struct {
 	frameBase
 	encoding   lib.Encoding
@@ -154,6 +262,20 @@ func (f InvolvedPeopleListFrame) PeopleList() []string {
 	return f.peopleList
 }
 
+// People will return people list as ordered involvement and involvee pairs.
+func (f InvolvedPeopleListFrame) People() []InvolvedPerson {
+	people := make([]InvolvedPerson, 0, len(f.peopleList)/2)
+
+	for i := 0; i+1 < len(f.peopleList); i += 2 {
+		people = append(people, InvolvedPerson{
+			Involvement: f.peopleList[i],
+			Involvee:    f.peopleList[i+1],
+		})
+	}
+
+	return people
+}
+
 type URLLinkFrame struct {
 	frameBase
 	url string
@@ -172,20 +294,173 @@ func (f MusicCDIdentifierFrame) CDTOC() []byte {
 	return f.cdTOC
 }
 
+// TOC will decode CD table of contents.
+func (f MusicCDIdentifierFrame) TOC() (lib.TOC, error) {
+	return lib.ParseTOC(f.cdTOC)
+}
+
 type TimeStampFormat byte
 
+const (
+	TimeStampFormatMPEGFrames   TimeStampFormat = 1
+	TimeStampFormatMilliseconds TimeStampFormat = 2
+)
+
+// Duration will convert time stamp to duration, frameDuration is used for MPEG frames time stamps.
+func (f TimeStampFormat) Duration(timeStamp int, frameDuration time.Duration) time.Duration {
+	if f == TimeStampFormatMPEGFrames {
+		return time.Duration(timeStamp) * frameDuration
+	}
+
+	return time.Duration(timeStamp) * time.Millisecond
+}
+
+// TimeStamp will convert duration to time stamp, frameDuration is used for MPEG frames time stamps.
+func (f TimeStampFormat) TimeStamp(d time.Duration, frameDuration time.Duration) int {
+	if f == TimeStampFormatMPEGFrames {
+		if frameDuration == 0 {
+			return 0
+		}
+
+		return int(d / frameDuration)
+	}
+
+	return int(d / time.Millisecond)
+}
+
+type EventType byte
+
+const (
+	EventTypePadding                EventType = 0x00
+	EventTypeEndOfInitialSilence    EventType = 0x01
+	EventTypeIntroStart             EventType = 0x02
+	EventTypeMainPartStart          EventType = 0x03
+	EventTypeOutroStart             EventType = 0x04
+	EventTypeOutroEnd               EventType = 0x05
+	EventTypeVerseStart             EventType = 0x06
+	EventTypeRefrainStart           EventType = 0x07
+	EventTypeInterlude              EventType = 0x08
+	EventTypeThemeStart             EventType = 0x09
+	EventTypeVariation              EventType = 0x0a
+	EventTypeKeyChange              EventType = 0x0b
+	EventTypeTimeChange             EventType = 0x0c
+	EventTypeMomentaryUnwantedNoise EventType = 0x0d
+	EventTypeSustainedNoise         EventType = 0x0e
+	EventTypeSustainedNoiseEnd      EventType = 0x0f
+	EventTypeIntroEnd               EventType = 0x10
+	EventTypeMainPartEnd            EventType = 0x11
+	EventTypeVerseEnd               EventType = 0x12
+	EventTypeRefrainEnd             EventType = 0x13
+	EventTypeThemeEnd               EventType = 0x14
+	EventTypeProfanity              EventType = 0x15
+	EventTypeProfanityEnd           EventType = 0x16
+	EventTypeAudioEnd               EventType = 0xfd
+	EventTypeAudioFileEnds          EventType = 0xfe
+)
+
+type Event struct {
+	Type      EventType
+	TimeStamp int
+}
+
 type EventTimingCodesFrame struct {
 	frameBase
 	timeStampFormat TimeStampFormat
+	events          []Event
 }
 
 func (f EventTimingCodesFrame) TimeStampFormat() TimeStampFormat {
 	return f.timeStampFormat
 }
 
-// 4.7.   MPEG location lookup table
+func (f EventTimingCodesFrame) Events() []Event {
+	return f.events
+}
+
+// 4.7.   MPEG location lookup table.
+type MPEGLocationLookupTableReference struct {
+	BytesDeviation        int
+	MillisecondsDeviation int
+}
+
+type MPEGLocationLookupTableFrame struct {
+	frameBase
+	mpegFramesBetweenReference   int
+	bytesBetweenReference        int
+	millisecondsBetweenReference int
+	bitsForBytesDeviation        int
+	bitsForMillisecondsDeviation int
+	references                   []MPEGLocationLookupTableReference
+}
+
+func (f MPEGLocationLookupTableFrame) MPEGFramesBetweenReference() int {
+	return f.mpegFramesBetweenReference
+}
+
+func (f MPEGLocationLookupTableFrame) BytesBetweenReference() int {
+	return f.bytesBetweenReference
+}
+
+func (f MPEGLocationLookupTableFrame) MillisecondsBetweenReference() int {
+	return f.millisecondsBetweenReference
+}
+
+func (f MPEGLocationLookupTableFrame) BitsForBytesDeviation() int {
+	return f.bitsForBytesDeviation
+}
+
+func (f MPEGLocationLookupTableFrame) BitsForMillisecondsDeviation() int {
+	return f.bitsForMillisecondsDeviation
+}
+
+func (f MPEGLocationLookupTableFrame) References() []MPEGLocationLookupTableReference {
+	return f.references
+}
+
+// Seek will return byte offset of d from the first MPEG frame of audio.
+// Offsets between references are interpolated and offsets after the last reference are extrapolated.
+func (f MPEGLocationLookupTableFrame) Seek(d time.Duration) int {
+	ms := int(d / time.Millisecond)
+	offset, t := 0, 0
+
+	for _, r := range f.references {
+		nextOffset := offset + f.bytesBetweenReference + r.BytesDeviation
+		nextT := t + f.millisecondsBetweenReference + r.MillisecondsDeviation
+
+		if nextT > ms {
+			return offset + (nextOffset-offset)*(ms-t)/(nextT-t)
+		}
+
+		offset, t = nextOffset, nextT
+	}
+
+	if f.millisecondsBetweenReference == 0 {
+		return offset
+	}
+
+	return offset + f.bytesBetweenReference*(ms-t)/f.millisecondsBetweenReference
+}
+
+// 4.8.   Synced tempo codes.
+type Tempo struct {
+	// BPM is beats per minute, 0 means beat-free and 1 means a single beat-stroke followed by a beat-free period.
+	BPM       int
+	TimeStamp int
+}
+
+type SyncedTempoCodesFrame struct {
+	frameBase
+	timeStampFormat TimeStampFormat
+	tempos          []Tempo
+}
+
+func (f SyncedTempoCodesFrame) TimeStampFormat() TimeStampFormat {
+	return f.timeStampFormat
+}
 
-// 4.8.   Synced tempo codes
+func (f SyncedTempoCodesFrame) Tempos() []Tempo {
+	return f.tempos
+}
 
 type UnsynchronisedLyricsOrTextTranscriptionFrame struct {
 	frameBase
@@ -207,6 +482,31 @@ func (f UnsynchronisedLyricsOrTextTranscriptionFrame) LyricsOrText() string {
 	return f.lyricsOrText
 }
 
+// NewUnsynchronisedLyricsOrTextTranscriptionFrame will return unsynchronised lyrics frame,
+// language is ISO 639-2 code and "XXX" is used for unknown languages.
+func NewUnsynchronisedLyricsOrTextTranscriptionFrame(
+	language, contentDescriptor, lyricsOrText string,
+) UnsynchronisedLyricsOrTextTranscriptionFrame {
+	frame := UnsynchronisedLyricsOrTextTranscriptionFrame{
+		frameBase:         frameBase{id: "USLT"},
+		textEncoding:      lib.Encodings[3],
+		language:          languageCode(language),
+		contentDescriptor: contentDescriptor,
+		lyricsOrText:      lyricsOrText,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f UnsynchronisedLyricsOrTextTranscriptionFrame) Body() []byte {
+	body := append([]byte{f.textEncoding.Byte()}, f.language...)
+	body = append(body, lib.FromUTF8(f.contentDescriptor, f.textEncoding)...)
+	body = append(body, f.textEncoding.Termination()...)
+
+	return append(body, lib.FromUTF8(f.lyricsOrText, f.textEncoding)...)
+}
+
 // 4.10.   Synchronised lyrics/text
 
 type CommentsFrame struct {
@@ -229,13 +529,207 @@ func (f CommentsFrame) TheActualText() string {
 	return f.theActualText
 }
 
+// NewCommentsFrame will return comments frame, language is ISO 639-2 code and "XXX" is used for unknown languages.
+func NewCommentsFrame(language, shortContentDescription, theActualText string) CommentsFrame {
+	frame := CommentsFrame{
+		frameBase:               frameBase{id: "COMM"},
+		textEncoding:            lib.Encodings[3],
+		language:                languageCode(language),
+		shortContentDescription: shortContentDescription,
+		theActualText:           theActualText,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f CommentsFrame) Body() []byte {
+	body := append([]byte{f.textEncoding.Byte()}, f.language...)
+	body = append(body, lib.FromUTF8(f.shortContentDescription, f.textEncoding)...)
+	body = append(body, f.textEncoding.Termination()...)
+
+	return append(body, lib.FromUTF8(f.theActualText, f.textEncoding)...)
+}
+
+// languageCode will return language as three characters ISO 639-2 code, "XXX" for unknown languages.
+func languageCode(language string) string {
+	if len(language) != 3 {
+		return "XXX"
+	}
+
+	return language
+}
+
 // 4.12.   Relative volume adjustment
 
-// 4.13.   Equalisation
+// 4.13.   Equalisation.
+type InterpolationMethod byte
+
+const (
+	InterpolationMethodBand InterpolationMethod = iota
+	InterpolationMethodLinear
+)
+
+type EqualisationPoint struct {
+	// Frequency is in units of 1/2 Hz.
+	Frequency int
+	// VolumeAdjustment is in units of 1/512 dB.
+	VolumeAdjustment int
+}
+
+// Hz will return frequency in Hz.
+func (p EqualisationPoint) Hz() float64 {
+	return float64(p.Frequency) / 2
+}
+
+// DB will return volume adjustment in dB.
+func (p EqualisationPoint) DB() float64 {
+	return float64(p.VolumeAdjustment) / 512
+}
+
+type EqualisationFrame struct {
+	frameBase
+	interpolationMethod InterpolationMethod
+	identification      string
+	points              []EqualisationPoint
+}
+
+func NewEqualisationFrame(method InterpolationMethod, identification string, points []EqualisationPoint) EqualisationFrame {
+	frame := EqualisationFrame{
+		frameBase:           frameBase{id: "EQU2"},
+		interpolationMethod: method,
+		identification:      identification,
+		points:              points,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f EqualisationFrame) InterpolationMethod() InterpolationMethod {
+	return f.interpolationMethod
+}
+
+func (f EqualisationFrame) Identification() string {
+	return f.identification
+}
+
+func (f EqualisationFrame) Points() []EqualisationPoint {
+	return f.points
+}
+
+// Body will return encoded frame body.
+func (f EqualisationFrame) Body() []byte {
+	body := make([]byte, 0, 2+len(f.identification)+len(f.points)*4)
+	body = append(body, byte(f.interpolationMethod))
+	body = append(body, f.identification...)
+	body = append(body, 0)
+
+	for _, point := range f.points {
+		body = append(body, lib.IntToByte(point.Frequency, 2)...)
+		body = append(body, lib.IntToByte(point.VolumeAdjustment, 2)...)
+	}
+
+	return body
+}
+
+// 4.14.   Reverb.
+type ReverbFrame struct {
+	frameBase
+	reverbLeft           int
+	reverbRight          int
+	reverbBouncesLeft    uint8
+	reverbBouncesRight   uint8
+	feedbackLeftToLeft   uint8
+	feedbackLeftToRight  uint8
+	feedbackRightToRight uint8
+	feedbackRightToLeft  uint8
+	premixLeftToRight    uint8
+	premixRightToLeft    uint8
+}
+
+// NewReverbFrame will return reverb frame, arguments are in the order of frame fields and reverbs are in milliseconds.
+func NewReverbFrame(
+	reverbLeft, reverbRight int,
+	reverbBouncesLeft, reverbBouncesRight,
+	feedbackLeftToLeft, feedbackLeftToRight, feedbackRightToRight, feedbackRightToLeft,
+	premixLeftToRight, premixRightToLeft uint8,
+) ReverbFrame {
+	frame := ReverbFrame{
+		frameBase:            frameBase{id: "RVRB"},
+		reverbLeft:           reverbLeft,
+		reverbRight:          reverbRight,
+		reverbBouncesLeft:    reverbBouncesLeft,
+		reverbBouncesRight:   reverbBouncesRight,
+		feedbackLeftToLeft:   feedbackLeftToLeft,
+		feedbackLeftToRight:  feedbackLeftToRight,
+		feedbackRightToRight: feedbackRightToRight,
+		feedbackRightToLeft:  feedbackRightToLeft,
+		premixLeftToRight:    premixLeftToRight,
+		premixRightToLeft:    premixRightToLeft,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+// ReverbLeft is delay between bounces in milliseconds.
+func (f ReverbFrame) ReverbLeft() int {
+	return f.reverbLeft
+}
+
+// ReverbRight is delay between bounces in milliseconds.
+func (f ReverbFrame) ReverbRight() int {
+	return f.reverbRight
+}
+
+func (f ReverbFrame) ReverbBouncesLeft() uint8 {
+	return f.reverbBouncesLeft
+}
+
+func (f ReverbFrame) ReverbBouncesRight() uint8 {
+	return f.reverbBouncesRight
+}
+
+func (f ReverbFrame) FeedbackLeftToLeft() uint8 {
+	return f.feedbackLeftToLeft
+}
+
+func (f ReverbFrame) FeedbackLeftToRight() uint8 {
+	return f.feedbackLeftToRight
+}
+
+func (f ReverbFrame) FeedbackRightToRight() uint8 {
+	return f.feedbackRightToRight
+}
+
+func (f ReverbFrame) FeedbackRightToLeft() uint8 {
+	return f.feedbackRightToLeft
+}
+
+func (f ReverbFrame) PremixLeftToRight() uint8 {
+	return f.premixLeftToRight
+}
+
+func (f ReverbFrame) PremixRightToLeft() uint8 {
+	return f.premixRightToLeft
+}
 
-// 4.14.   Reverb
+// Body will return encoded frame body.
+func (f ReverbFrame) Body() []byte {
+	body := make([]byte, 0, 12)
+	body = append(body, lib.IntToByte(f.reverbLeft, 2)...)
+	body = append(body, lib.IntToByte(f.reverbRight, 2)...)
+
+	return append(body,
+		f.reverbBouncesLeft, f.reverbBouncesRight,
+		f.feedbackLeftToLeft, f.feedbackLeftToRight, f.feedbackRightToRight, f.feedbackRightToLeft,
+		f.premixLeftToRight, f.premixRightToLeft,
+	)
+}
 
-type PictureType int
+// PictureType is type of attached picture.
+type PictureType = lib.PictureType
 
 const (
 	PictureTypeOther PictureType = iota
@@ -270,36 +764,107 @@ type AttachedPictureFrame struct {
 	pictureData  []byte
 }
 
+// Image will decode picture, format is sniffed from picture data and lib.ErrPictureLink is returned for links.
 func (f AttachedPictureFrame) Image() (image.Image, error) {
-	switch f.mimeType {
-	case "image/jpeg":
-		res, err := jpeg.Decode(bytes.NewReader(f.pictureData))
-		if err != nil {
-			return nil, fmt.Errorf("error on decode jpeg: %w", err)
-		}
+	res, err := lib.DecodeImage(f.mimeType, f.pictureData)
+	if err != nil {
+		return nil, fmt.Errorf("error on decode picture: %w", err)
+	}
 
-		return res, nil
-	case "image/png":
-		res, err := png.Decode(bytes.NewReader(f.pictureData))
-		if err != nil {
-			return nil, fmt.Errorf("error on decode png: %w", err)
-		}
+	return res, nil
+}
 
-		return res, nil
-	default:
-		return nil, errors.New("invalid image format")
+// Link will return URL of picture when picture is a link, which MIME type is "-->".
+func (f AttachedPictureFrame) Link() (string, bool) {
+	if strings.TrimSpace(f.mimeType) != lib.PictureLinkMIMEType {
+		return "", false
 	}
+
+	return strings.TrimRight(string(f.pictureData), "\x00"), true
+}
+
+// MIMEType is MIME type of picture, like "image/png".
+func (f AttachedPictureFrame) MIMEType() string {
+	return f.mimeType
+}
+
+// Type is picture type, like front cover.
+func (f AttachedPictureFrame) Type() PictureType {
+	return f.pictureType
 }
 
 func (f AttachedPictureFrame) Description() string {
 	return f.description
 }
 
-// 4.16. General encapsulated object
+// Data is raw bytes of picture.
+func (f AttachedPictureFrame) Data() []byte {
+	return f.pictureData
+}
+
+// 4.15. General encapsulated object.
+type GeneralEncapsulatedObjectFrame struct {
+	frameBase
+	textEncoding       lib.Encoding
+	mimeType           string
+	filename           string
+	contentDescription string
+	encapsulatedObject []byte
+}
+
+func (f GeneralEncapsulatedObjectFrame) MIMEType() string {
+	return f.mimeType
+}
+
+func (f GeneralEncapsulatedObjectFrame) Filename() string {
+	return f.filename
+}
+
+func (f GeneralEncapsulatedObjectFrame) Description() string {
+	return f.contentDescription
+}
+
+func (f GeneralEncapsulatedObjectFrame) Data() []byte {
+	return f.encapsulatedObject
+}
+
+// Extract will write encapsulated object into dir and return the written file path, existing files are not overwritten.
+// Content description is used as file name when object has no filename.
+func (f GeneralEncapsulatedObjectFrame) Extract(dir string) (string, error) {
+	name := f.filename
+	if name == "" {
+		name = f.contentDescription
+	}
+
+	return lib.WriteFile(dir, name, f.encapsulatedObject)
+}
+
+// Private frame.
+type PrivateFrame struct {
+	frameBase
+	ownerIdentifier string
+	privateData     []byte
+}
+
+func (f PrivateFrame) OwnerIdentifier() string {
+	return f.ownerIdentifier
+}
+
+func (f PrivateFrame) Data() []byte {
+	return f.privateData
+}
 
-// 4.17. Play counter
+// 4.16. Play counter.
+type PlayCounterFrame struct {
+	frameBase
+	counter int
+}
+
+func (f PlayCounterFrame) Counter() int {
+	return f.counter
+}
 
-// 4.18. Popularimeter.
+// 4.17. Popularimeter.
 type PopularimeterFrame struct {
 	frameBase
 	emailToUser string
@@ -319,72 +884,448 @@ func (f PopularimeterFrame) Counter() int {
 	return f.counter
 }
 
-// 4.19.   Recommended buffer size
+// 4.18. Recommended buffer size.
+type RecommendedBufferSizeFrame struct {
+	frameBase
+	bufferSize      int
+	embeddedInfo    bool
+	offsetToNextTag int
+}
 
-// 4.20.   Encrypted meta frame
+func NewRecommendedBufferSizeFrame(bufferSize int, embeddedInfo bool, offsetToNextTag int) RecommendedBufferSizeFrame {
+	frame := RecommendedBufferSizeFrame{
+		frameBase:       frameBase{id: "RBUF"},
+		bufferSize:      bufferSize,
+		embeddedInfo:    embeddedInfo,
+		offsetToNextTag: offsetToNextTag,
+	}
+	frame.size = len(frame.Body())
 
-// 4.21.   Audio encryption
+	return frame
+}
 
-// 4.22.   Linked information
+// BufferSize is recommended buffer size in bytes.
+func (f RecommendedBufferSizeFrame) BufferSize() int {
+	return f.bufferSize
+}
 
-type DeclaredFrame struct {
-	ID          string
-	Description string
-	Type        FrameType
+// EmbeddedInfo reports whether a tag with a maximum size of buffer size may occur in the audio stream.
+func (f RecommendedBufferSizeFrame) EmbeddedInfo() bool {
+	return f.embeddedInfo
 }
 
-var DeclaredFrames = map[string]DeclaredFrame{
-	"AENC": {"AENC", "Audio encryption", TypeUnknown},
-	"APIC": {"APIC", "Attached picture", TypeAttachedPicture},
-	"ASPI": {"ASPI", "Audio seek point index", TypeUnknown},
-	"COMM": {"COMM", "Comments", TypeUnknown},
-	"COMR": {"COMR", "Commercial frame", TypeUnknown},
-	"ENCR": {"ENCR", "Encryption method registration", TypeUnknown},
-	"EQU2": {"EQU2", "Equalisation (2)", TypeUnknown},
-	"ETCO": {"ETCO", "Event timing codes", TypeUnknown},
-	"GEOB": {"GEOB", "General encapsulated object", TypeUnknown},
-	"GRID": {"GRID", "Group identification registration", TypeUnknown},
-	"LINK": {"LINK", "Linked information", TypeUnknown},
-	"MCDI": {"MCDI", "Music CD identifier", TypeUnknown},
-	"MLLT": {"MLLT", "MPEG location lookup table", TypeUnknown},
-	"OWNE": {"OWNE", "Ownership frame", TypeUnknown},
-	"PRIV": {"PRIV", "Private frame", TypeUnknown},
-	"PCNT": {"PCNT", "Play counter", TypeUnknown},
-	"POPM": {"POPM", "Popularimeter", TypePopularimeter},
-	"POSS": {"POSS", "Position synchronisation frame", TypeUnknown},
-	"RBUF": {"RBUF", "Recommended buffer size", TypeUnknown},
-	"RVA2": {"RVA2", "Relative volume adjustment (2)", TypeUnknown},
-	"RVRB": {"RVRB", "Reverb", TypeUnknown},
-	"SEEK": {"SEEK", "Seek frame", TypeUnknown},
-	"SIGN": {"SIGN", "Signature frame", TypeUnknown},
-	"SYLT": {"SYLT", "Synchronised lyric/text", TypeUnknown},
-	"SYTC": {"SYTC", "Synchronised tempo codes", TypeUnknown},
+// OffsetToNextTag is offset from end of tag to next tag in bytes, zero if it is not known.
+func (f RecommendedBufferSizeFrame) OffsetToNextTag() int {
+	return f.offsetToNextTag
+}
 
-	"TALB": {"TALB", "Album/Movie/Show title", TypeTextInformation},
-	"TBPM": {"TBPM", "BPM (beats per minute)", TypeTextInformation},
-	"TCOM": {"TCOM", "Composer", TypeTextInformation},
-	"TCON": {"TCON", "Content type", TypeTextInformation},
-	"TCOP": {"TCOP", "Copyright message", TypeTextInformation},
-	"TDEN": {"TDEN", "Encoding time", TypeTextInformation},
-	"TDLY": {"TDLY", "Playlist delay", TypeTextInformation},
-	"TDOR": {"TDOR", "Original release time", TypeTextInformation},
-	"TDRC": {"TDRC", "Recording time", TypeTextInformation},
-	"TDRL": {"TDRL", "Release time", TypeTextInformation},
-	"TDTG": {"TDTG", "Tagging time", TypeTextInformation},
-	"TENC": {"TENC", "Encoded by", TypeTextInformation},
-	"TEXT": {"TEXT", "Lyricist/Text writer", TypeTextInformation},
-	"TFLT": {"TFLT", "File type", TypeTextInformation},
-	"TIPL": {"TIPL", "Involved people list", TypeTextInformation},
-	"TIT1": {"TIT1", "Content group description", TypeTextInformation},
-	"TIT2": {"TIT2", "Title/songname/content description", TypeTextInformation},
-	"TIT3": {"TIT3", "Subtitle/Description refinement", TypeTextInformation},
-	"TKEY": {"TKEY", "Initial key", TypeTextInformation},
-	"TLAN": {"TLAN", "Language(s)", TypeTextInformation},
-	"TLEN": {"TLEN", "Length", TypeTextInformation},
-	"TMCL": {"TMCL", "Musician credits list", TypeTextInformation},
-	"TMED": {"TMED", "Media type", TypeTextInformation},
-	"TMOO": {"TMOO", "Mood", TypeTextInformation},
-	"TOAL": {"TOAL", "Original album/movie/show title", TypeTextInformation},
+func (f RecommendedBufferSizeFrame) Body() []byte {
+	body := make([]byte, 0, 8)
+	body = append(body, lib.IntToByte(f.bufferSize, 3)...)
+
+	if f.embeddedInfo {
+		body = append(body, 1)
+	} else {
+		body = append(body, 0)
+	}
+
+	if f.offsetToNextTag > 0 {
+		body = append(body, lib.IntToByte(f.offsetToNextTag, 4)...)
+	}
+
+	return body
+}
+
+// 4.19. Audio encryption.
+type AudioEncryptionFrame struct {
+	frameBase
+	ownerIdentifier string
+	previewStart    int
+	previewLength   int
+	encryptionInfo  []byte
+}
+
+func (f AudioEncryptionFrame) OwnerIdentifier() string {
+	return f.ownerIdentifier
+}
+
+// PreviewStart is start of unencrypted part of audio in frames.
+func (f AudioEncryptionFrame) PreviewStart() int {
+	return f.previewStart
+}
+
+// PreviewLength is length of unencrypted part of audio in frames.
+func (f AudioEncryptionFrame) PreviewLength() int {
+	return f.previewLength
+}
+
+func (f AudioEncryptionFrame) EncryptionInfo() []byte {
+	return f.encryptionInfo
+}
+
+// 4.25. Encryption method registration.
+type EncryptionMethodRegistrationFrame struct {
+	frameBase
+	ownerIdentifier string
+	methodSymbol    byte
+	encryptionData  []byte
+}
+
+func (f EncryptionMethodRegistrationFrame) OwnerIdentifier() string {
+	return f.ownerIdentifier
+}
+
+func (f EncryptionMethodRegistrationFrame) MethodSymbol() byte {
+	return f.methodSymbol
+}
+
+func (f EncryptionMethodRegistrationFrame) EncryptionData() []byte {
+	return f.encryptionData
+}
+
+// 4.26. Group identification registration.
+type GroupIdentificationRegistrationFrame struct {
+	frameBase
+	ownerIdentifier    string
+	symbol             byte
+	groupDependentData []byte
+}
+
+func (f GroupIdentificationRegistrationFrame) OwnerIdentifier() string {
+	return f.ownerIdentifier
+}
+
+// Symbol is group symbol which frames of the group refer to, see Tag.Group.
+func (f GroupIdentificationRegistrationFrame) Symbol() byte {
+	return f.symbol
+}
+
+func (f GroupIdentificationRegistrationFrame) GroupDependentData() []byte {
+	return f.groupDependentData
+}
+
+// 4.28. Signature frame.
+type SignatureFrame struct {
+	frameBase
+	symbol    byte
+	signature []byte
+}
+
+// Symbol is group symbol of frames which are signed.
+func (f SignatureFrame) Symbol() byte {
+	return f.symbol
+}
+
+func (f SignatureFrame) Signature() []byte {
+	return f.signature
+}
+
+// EncryptedFrame is a frame which is encrypted and not decrypted yet, see Tag.Decrypt.
+// Data is encrypted data, unsynchronisation is already reversed.
+type EncryptedFrame struct {
+	frameBase
+	data []byte
+}
+
+func (f EncryptedFrame) Data() []byte {
+	return f.data
+}
+
+// 4.20. Linked information.
+type LinkedInformationFrame struct {
+	frameBase
+	frameIdentifier string
+	url             string
+	idData          []byte
+}
+
+// FrameIdentifier is id of the linked frame.
+func (f LinkedInformationFrame) FrameIdentifier() string {
+	return f.frameIdentifier
+}
+
+// URL is location of file which contains the linked frame.
+func (f LinkedInformationFrame) URL() string {
+	return f.url
+}
+
+// IDData is additional data which identifies the linked frame, like content descriptor of attached picture.
+func (f LinkedInformationFrame) IDData() []byte {
+	return f.idData
+}
+
+// 4.21. Position synchronisation frame.
+type PositionSynchronisationFrame struct {
+	frameBase
+	timeStampFormat TimeStampFormat
+	position        int
+}
+
+func NewPositionSynchronisationFrame(timeStampFormat TimeStampFormat, position int) PositionSynchronisationFrame {
+	frame := PositionSynchronisationFrame{
+		frameBase:       frameBase{id: "POSS"},
+		timeStampFormat: timeStampFormat,
+		position:        position,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+func (f PositionSynchronisationFrame) TimeStampFormat() TimeStampFormat {
+	return f.timeStampFormat
+}
+
+// Position is position in audio where the stream is joined, in unit of time stamp format.
+func (f PositionSynchronisationFrame) Position() int {
+	return f.position
+}
+
+func (f PositionSynchronisationFrame) Body() []byte {
+	return append([]byte{byte(f.timeStampFormat)}, lib.IntToByte(f.position, 4)...)
+}
+
+// 4.23. Ownership frame.
+type OwnershipFrame struct {
+	frameBase
+	textEncoding   lib.Encoding
+	pricePaid      string
+	dateOfPurchase string
+	seller         string
+}
+
+// PricePaid is currency code followed by price, like "USD1.99".
+func (f OwnershipFrame) PricePaid() string {
+	return f.pricePaid
+}
+
+// DateOfPurchase is in YYYYMMDD format.
+func (f OwnershipFrame) DateOfPurchase() string {
+	return f.dateOfPurchase
+}
+
+func (f OwnershipFrame) Seller() string {
+	return f.seller
+}
+
+type ReceivedAs byte
+
+const (
+	ReceivedAsOther ReceivedAs = iota
+	ReceivedAsStandardCDAlbum
+	ReceivedAsCompressedAudioOnCD
+	ReceivedAsFileOverTheInternet
+	ReceivedAsStreamOverTheInternet
+	ReceivedAsNoteSheets
+	ReceivedAsNoteSheetsInABook
+	ReceivedAsMusicOnOtherMedia
+	ReceivedAsNonMusicalMerchandise
+)
+
+// 4.24. Commercial frame.
+type CommercialFrame struct {
+	frameBase
+	textEncoding    lib.Encoding
+	priceString     string
+	validUntil      string
+	contactURL      string
+	receivedAs      ReceivedAs
+	nameOfSeller    string
+	description     string
+	pictureMIMEType string
+	sellerLogo      []byte
+}
+
+// PriceString is prices separated by "/", each price is currency code followed by price, like "USD1.99/EUR1.50".
+func (f CommercialFrame) PriceString() string {
+	return f.priceString
+}
+
+func (f CommercialFrame) Prices() []lib.Price {
+	return lib.ParsePrices(f.priceString)
+}
+
+// ValidUntil is in YYYYMMDD format.
+func (f CommercialFrame) ValidUntil() string {
+	return f.validUntil
+}
+
+func (f CommercialFrame) ContactURL() string {
+	return f.contactURL
+}
+
+func (f CommercialFrame) ReceivedAs() ReceivedAs {
+	return f.receivedAs
+}
+
+func (f CommercialFrame) NameOfSeller() string {
+	return f.nameOfSeller
+}
+
+func (f CommercialFrame) Description() string {
+	return f.description
+}
+
+func (f CommercialFrame) PictureMIMEType() string {
+	return f.pictureMIMEType
+}
+
+func (f CommercialFrame) SellerLogo() []byte {
+	return f.sellerLogo
+}
+
+// 4.30. Audio seek point index.
+type AudioSeekPointIndexFrame struct {
+	frameBase
+	indexedDataStart  int
+	indexedDataLength int
+	bitsPerIndexPoint int
+	indexPoints       []int
+}
+
+// NewAudioSeekPointIndexFrame will return audio seek point index frame, bits per index point must be 8 or 16.
+func NewAudioSeekPointIndexFrame(start, length, bitsPerIndexPoint int, points []int) AudioSeekPointIndexFrame {
+	frame := AudioSeekPointIndexFrame{
+		frameBase:         frameBase{id: "ASPI"},
+		indexedDataStart:  start,
+		indexedDataLength: length,
+		bitsPerIndexPoint: bitsPerIndexPoint,
+		indexPoints:       points,
+	}
+	frame.size = len(frame.Body())
+
+	return frame
+}
+
+// IndexedDataStart is byte offset of indexed data from the beginning of the file.
+func (f AudioSeekPointIndexFrame) IndexedDataStart() int {
+	return f.indexedDataStart
+}
+
+func (f AudioSeekPointIndexFrame) IndexedDataLength() int {
+	return f.indexedDataLength
+}
+
+func (f AudioSeekPointIndexFrame) BitsPerIndexPoint() int {
+	return f.bitsPerIndexPoint
+}
+
+// IndexPoints are fractions of indexed data length in 2^bits scale, for equally spaced points of time.
+func (f AudioSeekPointIndexFrame) IndexPoints() []int {
+	return f.indexPoints
+}
+
+// Body will return encoded frame body.
+func (f AudioSeekPointIndexFrame) Body() []byte {
+	pointSize := f.bitsPerIndexPoint / 8
+	body := make([]byte, 0, 11+len(f.indexPoints)*pointSize)
+	body = append(body, lib.IntToByte(f.indexedDataStart, 4)...)
+	body = append(body, lib.IntToByte(f.indexedDataLength, 4)...)
+	body = append(body, lib.IntToByte(len(f.indexPoints), 2)...)
+	body = append(body, byte(f.bitsPerIndexPoint))
+
+	for _, point := range f.indexPoints {
+		body = append(body, lib.IntToByte(point, pointSize)...)
+	}
+
+	return body
+}
+
+// Seek will return file offset of d in audio with the given duration.
+// Offsets between index points are interpolated.
+func (f AudioSeekPointIndexFrame) Seek(d, duration time.Duration) int {
+	n := len(f.indexPoints)
+	if n == 0 || duration <= 0 || d <= 0 {
+		return f.indexedDataStart
+	}
+
+	if d >= duration {
+		return f.indexedDataStart + f.indexedDataLength
+	}
+
+	offset := func(i int) float64 {
+		if i >= n {
+			return float64(f.indexedDataLength)
+		}
+
+		return float64(f.indexPoints[i]) * float64(f.indexedDataLength) / float64(int(1)<<f.bitsPerIndexPoint)
+	}
+
+	position := float64(d) / float64(duration) * float64(n)
+	i := int(position)
+
+	return f.indexedDataStart + int(offset(i)+(offset(i+1)-offset(i))*(position-float64(i)))
+}
+
+// ItunesCompilationFlagFrame is iTunes extension which marks a track as part of a compilation.
+type ItunesCompilationFlagFrame struct {
+	frameBase
+	encoding             lib.Encoding
+	isPartOfACompilation bool
+}
+
+func (f ItunesCompilationFlagFrame) IsPartOfACompilation() bool {
+	return f.isPartOfACompilation
+}
+
+type DeclaredFrame struct {
+	ID          string
+	Description string
+	Type        FrameType
+}
+
+var DeclaredFrames = map[string]DeclaredFrame{
+	"AENC": {"AENC", "Audio encryption", TypeAudioEncryption},
+	"APIC": {"APIC", "Attached picture", TypeAttachedPicture},
+	"ASPI": {"ASPI", "Audio seek point index", TypeAudioSeekPointIndex},
+	"COMM": {"COMM", "Comments", TypeComments},
+	"COMR": {"COMR", "Commercial frame", TypeCommercial},
+	"ENCR": {"ENCR", "Encryption method registration", TypeEncryptionMethodRegistration},
+	"EQU2": {"EQU2", "Equalisation (2)", TypeEqualisation},
+	"ETCO": {"ETCO", "Event timing codes", TypeEventTimingCodes},
+	"GEOB": {"GEOB", "General encapsulated object", TypeGeneralEncapsulatedObject},
+	"GRID": {"GRID", "Group identification registration", TypeGroupIdentificationRegistration},
+	"LINK": {"LINK", "Linked information", TypeLinkedInformation},
+	"MCDI": {"MCDI", "Music CD identifier", TypeMusicCDIdentifier},
+	"MLLT": {"MLLT", "MPEG location lookup table", TypeMPEGLocationLookupTable},
+	"OWNE": {"OWNE", "Ownership frame", TypeOwnership},
+	"PRIV": {"PRIV", "Private frame", TypePrivate},
+	"PCNT": {"PCNT", "Play counter", TypePlayCounter},
+	"POPM": {"POPM", "Popularimeter", TypePopularimeter},
+	"POSS": {"POSS", "Position synchronisation frame", TypePositionSynchronisation},
+	"RBUF": {"RBUF", "Recommended buffer size", TypeRecommendedBufferSize},
+	"RVA2": {"RVA2", "Relative volume adjustment (2)", TypeUnknown},
+	"RVRB": {"RVRB", "Reverb", TypeReverb},
+	"SEEK": {"SEEK", "Seek frame", TypeUnknown},
+	"SIGN": {"SIGN", "Signature frame", TypeSignature},
+	"SYLT": {"SYLT", "Synchronised lyric/text", TypeUnknown},
+	"SYTC": {"SYTC", "Synchronised tempo codes", TypeSyncedTempoCodes},
+
+	"TALB": {"TALB", "Album/Movie/Show title", TypeTextInformation},
+	"TBPM": {"TBPM", "BPM (beats per minute)", TypeTextInformation},
+	"TCOM": {"TCOM", "Composer", TypeTextInformation},
+	"TCON": {"TCON", "Content type", TypeTextInformation},
+	"TCOP": {"TCOP", "Copyright message", TypeTextInformation},
+	"TDEN": {"TDEN", "Encoding time", TypeTextInformation},
+	"TDLY": {"TDLY", "Playlist delay", TypeTextInformation},
+	"TDOR": {"TDOR", "Original release time", TypeTextInformation},
+	"TDRC": {"TDRC", "Recording time", TypeTextInformation},
+	"TDRL": {"TDRL", "Release time", TypeTextInformation},
+	"TDTG": {"TDTG", "Tagging time", TypeTextInformation},
+	"TENC": {"TENC", "Encoded by", TypeTextInformation},
+	"TEXT": {"TEXT", "Lyricist/Text writer", TypeTextInformation},
+	"TFLT": {"TFLT", "File type", TypeTextInformation},
+	"TIPL": {"TIPL", "Involved people list", TypeInvolvedPeopleList},
+	"TIT1": {"TIT1", "Content group description", TypeTextInformation},
+	"TIT2": {"TIT2", "Title/songname/content description", TypeTextInformation},
+	"TIT3": {"TIT3", "Subtitle/Description refinement", TypeTextInformation},
+	"TKEY": {"TKEY", "Initial key", TypeTextInformation},
+	"TLAN": {"TLAN", "Language(s)", TypeTextInformation},
+	"TLEN": {"TLEN", "Length", TypeTextInformation},
+	"TMCL": {"TMCL", "Musician credits list", TypeInvolvedPeopleList},
+	"TMED": {"TMED", "Media type", TypeTextInformation},
+	"TMOO": {"TMOO", "Mood", TypeTextInformation},
+	"TOAL": {"TOAL", "Original album/movie/show title", TypeTextInformation},
 	"TOFN": {"TOFN", "Original filename", TypeTextInformation},
 	"TOLY": {"TOLY", "Original lyricist(s)/text writer(s)", TypeTextInformation},
 	"TOPE": {"TOPE", "Original artist(s)/performer(s)", TypeTextInformation},
@@ -408,20 +1349,25 @@ var DeclaredFrames = map[string]DeclaredFrame{
 
 	"TXXX": {"TXXX", "User defined text information frame", TypeUserDefinedTextInformation},
 
-	"UFID": {"UFID", "Unique file identifier", TypeUnknown},
-	"USER": {"USER", "Terms of use", TypeUnknown},
-	"USLT": {"USLT", "Unsynchronised lyric/text transcription", TypeUnknown},
-	"WCOM": {"WCOM", "Commercial information", TypeUnknown},
-	"WCOP": {"WCOP", "Copyright/Legal information", TypeUnknown},
-	"WOAF": {"WOAF", "Official audio file webpage", TypeUnknown},
-	"WOAR": {"WOAR", "Official artist/performer webpage", TypeUnknown},
-	"WOAS": {"WOAS", "Official audio source webpage", TypeUnknown},
-	"WORS": {"WORS", "Official Internet radio station homepage", TypeUnknown},
-	"WPAY": {"WPAY", "Payment", TypeUnknown},
-	"WPUB": {"WPUB", "Publishers official webpage", TypeUnknown},
+	"UFID": {"UFID", "Unique file identifier", TypeUniqueFileIdentifier},
+	"USER": {"USER", "Terms of use", TypeTermOfUse},
+	"USLT": {"USLT", "Unsynchronised lyric/text transcription", TypeUnsychronisedLyricsOrTextTranscription},
+	"WCOM": {"WCOM", "Commercial information", TypeURLLink},
+	"WCOP": {"WCOP", "Copyright/Legal information", TypeURLLink},
+	"WOAF": {"WOAF", "Official audio file webpage", TypeURLLink},
+	"WOAR": {"WOAR", "Official artist/performer webpage", TypeURLLink},
+	"WOAS": {"WOAS", "Official audio source webpage", TypeURLLink},
+	"WORS": {"WORS", "Official Internet radio station homepage", TypeURLLink},
+	"WPAY": {"WPAY", "Payment", TypeURLLink},
+	"WPUB": {"WPUB", "Publishers official webpage", TypeURLLink},
 	"WXXX": {"WXXX", "User defined URL link frame", TypeUnknown},
 	// iTunes
-	"TCMP": {"TCMP", "Part of a compilation", TypeUnknown},
+	"TCMP": {"TCMP", "Part of a compilation", TypeiTunesCompilationFlag},
+	"TSO2": {"TSO2", "Album artist sort order", TypeTextInformation},
+	"TSOC": {"TSOC", "Composer sort order", TypeTextInformation},
+	"GRP1": {"GRP1", "Grouping", TypeTextInformation},
+	"MVNM": {"MVNM", "Movement name", TypeTextInformation},
+	"MVIN": {"MVIN", "Movement number/count", TypeTextInformation},
 }
 
 // Tag is ID3v2.4 tag reader.
@@ -452,7 +1398,7 @@ func New(f io.ReadSeeker) (*Tag, error) {
 	}
 
 	frames := make([]Frame, 0)
-	framesSize := lib.ByteToInt(header[6:10])
+	framesSize := lib.SyncsafeToInt(header[6:10])
 	flag := header[5]
 
 	for t := 0; t < framesSize; {
@@ -475,7 +1421,7 @@ func New(f io.ReadSeeker) (*Tag, error) {
 			return nil, errors.New("error on reading frames")
 		}
 
-		frameSize := lib.ByteToInt(frameHeader[4:8])
+		frameSize := lib.SyncsafeToInt(frameHeader[4:8])
 
 		frameBody := make([]byte, frameSize)
 
@@ -499,152 +1445,530 @@ func New(f io.ReadSeeker) (*Tag, error) {
 			flagDataLengthIndicator:   frameHeader[9]&1 == 1,
 		}
 
-		df, ok := DeclaredFrames[frameID]
-		if !ok {
-			frame := UnknownFrame{
-				frameBase: frameBase,
-				data:      frameBody,
-			}
-			frames = append(frames, frame)
+		if frameBase.flagGroupingIdentity && len(frameBody) > 0 {
+			frameBase.groupSymbol = frameBody[0]
+			frameBody = frameBody[1:]
+		}
+
+		if frameBase.flagEncryption && len(frameBody) > 0 {
+			frameBase.encryptionMethod = frameBody[0]
+			frameBody = frameBody[1:]
+		}
+
+		if frameBase.flagDataLengthIndicator && len(frameBody) >= 4 {
+			frameBase.dataLength = lib.SyncsafeToInt(frameBody[:4])
+			frameBody = frameBody[4:]
+		}
+
+		if frameBase.flagEncryption {
+			frames = append(frames, EncryptedFrame{frameBase: frameBase, data: frameBase.deunsynchronise(frameBody)})
 
 			continue
 		}
 
-		switch df.Type {
-		case TypeTextInformation:
-			frame := TextInformationFrame{
-				frameBase: frameBase,
-				encoding:  lib.Encodings[frameBody[0]],
-				text:      lib.ToUTF8(frameBody[1:], lib.Encodings[frameBody[0]]),
-			}
-			frames = append(frames, frame)
-		case TypeUserDefinedTextInformation:
-			frame := UserDefinedTextInformationFrame{
-				frameBase: frameBase,
-				encoding:  lib.Encodings[frameBody[0]],
-			}
+		frameBody, err = frameBase.decode(frameBody)
+		if err != nil {
+			frames = append(frames, UnknownFrame{frameBase: frameBase, data: frameBody})
 
-			for i := 1; i < frameSize; i += frame.encoding.Size {
-				if frameBody[i] == 0 {
-					frame.description = lib.ToUTF8(frameBody[1:i], frame.encoding)
-					frame.value = lib.ToUTF8(frameBody[i+frame.encoding.Size:], frame.encoding)
+			continue
+		}
 
-					break
-				}
-			}
+		frames = append(frames, newFrame(frameBase, frameBody))
+	}
 
-			frames = append(frames, frame)
-		case TypeURLLink:
-			frame := URLLinkFrame{
-				frameBase: frameBase,
-				url:       string(frameBody),
-			}
+	tag := new(Tag)
+	tag.frames = frames
+	tag.Size = framesSize
+	// Flags
+	tag.UnsynchronisationFlag = flag&128 == 128
+	tag.ExtendedHeaderFlag = flag&64 == 64
+	tag.ExperimentalIndicatorFlag = flag&32 == 32
+	tag.FooterPresentFlag = flag&16 == 16
 
-			frames = append(frames, frame)
-		case TypeAttachedPicture:
-			frame := AttachedPictureFrame{
-				frameBase:    frameBase,
-				textEncoding: lib.Encodings[frameBody[0]],
-			}
+	return tag, nil
+}
 
-			for i := 1; i < frameSize; i++ {
-				if frameBody[i] == 0 {
-					frame.mimeType = string(frameBody[1:i])
-					frame.pictureType = PictureType(frameBody[i+1])
+// encodedFrameTypes are frame types which body starts with a text encoding byte, bodies with an invalid
+// encoding are unknown frames.
+var encodedFrameTypes = map[FrameType]bool{
+	TypeGeneralEncapsulatedObject:              true,
+	TypeComments:                               true,
+	TypeUnsychronisedLyricsOrTextTranscription: true,
+	TypeInvolvedPeopleList:                     true,
+	TypeiTunesCompilationFlag:                  true,
+	TypeTermOfUse:                              true,
+	TypeOwnership:                              true,
+	TypeCommercial:                             true,
+}
 
-					for j := i + 2; j < frameSize; j += frame.textEncoding.Size {
-						if frameBody[j] == 0 {
-							frame.description = lib.ToUTF8(frameBody[i+2:j], frame.textEncoding)
-							frame.pictureData = frameBody[j+frame.textEncoding.Size:]
+func newFrame(frameBase frameBase, frameBody []byte) Frame {
+	frameSize := len(frameBody)
 
-							break
-						}
-					}
+	df, ok := DeclaredFrames[frameBase.id]
+	if !ok || len(frameBody) == 0 {
+		frame := UnknownFrame{
+			frameBase: frameBase,
+			data:      frameBody,
+		}
 
-					break
-				}
-			}
+		return frame
+	}
 
-			frames = append(frames, frame)
-		case TypeUnsychronisedLyricsOrTextTranscription:
-			frame := UnsynchronisedLyricsOrTextTranscriptionFrame{
-				frameBase:    frameBase,
-				textEncoding: lib.Encodings[frameBody[0]],
-				language:     string(frameBody[1:4]),
-			}
+	if encodedFrameTypes[df.Type] && !lib.IsValidEncoding(frameBody[0]) {
+		return UnknownFrame{frameBase: frameBase, data: frameBody}
+	}
 
-			for i := 4; i < frameSize; i += frame.textEncoding.Size {
-				if frameBody[i] == 0 {
-					frame.contentDescriptor = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
-					frame.lyricsOrText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
+	switch df.Type {
+	case TypeTextInformation:
+		frame := TextInformationFrame{
+			frameBase: frameBase,
+			encoding:  lib.Encodings[frameBody[0]],
+			text:      lib.ToUTF8(frameBody[1:], lib.Encodings[frameBody[0]]),
+		}
 
-					break
-				}
-			}
+		return frame
+	case TypeUserDefinedTextInformation:
+		frame := UserDefinedTextInformationFrame{
+			frameBase: frameBase,
+			encoding:  lib.Encodings[frameBody[0]],
+		}
 
-			frames = append(frames, frame)
-		case TypeComments:
-			frame := CommentsFrame{
-				frameBase:    frameBase,
-				textEncoding: lib.Encodings[frameBody[0]],
-				language:     string(frameBody[1:4]),
+		for i := 1; i < frameSize; i += frame.encoding.Size {
+			if frameBody[i] == 0 {
+				frame.description = lib.ToUTF8(frameBody[1:i], frame.encoding)
+				frame.value = lib.ToUTF8(frameBody[i+frame.encoding.Size:], frame.encoding)
+
+				break
 			}
+		}
+
+		return frame
+	case TypeURLLink:
+		frame := URLLinkFrame{
+			frameBase: frameBase,
+			url:       string(frameBody),
+		}
+
+		return frame
+	case TypeAttachedPicture:
+		frame := AttachedPictureFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+		}
+
+		for i := 1; i < frameSize; i++ {
+			if frameBody[i] == 0 {
+				frame.mimeType = string(frameBody[1:i])
+				frame.pictureType = PictureType(frameBody[i+1])
 
-			for i := 4; i < frameSize; i += frame.textEncoding.Size {
-				if frameBody[i] == 0 {
-					frame.shortContentDescription = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
-					frame.theActualText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
+				for j := i + 2; j < frameSize; j += frame.textEncoding.Size {
+					if frameBody[j] == 0 {
+						frame.description = lib.ToUTF8(frameBody[i+2:j], frame.textEncoding)
+						frame.pictureData = frameBody[j+frame.textEncoding.Size:]
 
-					break
+						break
+					}
 				}
-			}
 
-			frames = append(frames, frame)
-		case TypeTermOfUse:
-			frame := TermOfUseFrame{
-				frameBase:     frameBase,
-				textEncoding:  lib.Encodings[frameBody[0]],
-				language:      string(frameBody[1:4]),
-				theActualText: lib.ToUTF8(frameBody[4:], lib.Encodings[frameBody[0]]),
+				break
 			}
+		}
 
-			frames = append(frames, frame)
-		case TypePopularimeter:
-			frame := PopularimeterFrame{
-				frameBase: frameBase,
-			}
+		return frame
+	case TypeUnsychronisedLyricsOrTextTranscription:
+		if frameSize < 4 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
 
-			for i := 0; i < framesSize; i++ {
-				if frameBody[i] == 0 {
-					frame.emailToUser = string(frameBody[:i])
-					frame.rating = frameBody[i+1]
-					frame.counter = lib.ByteToInt(frameBody[i+2:])
+		frame := UnsynchronisedLyricsOrTextTranscriptionFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+			language:     string(frameBody[1:4]),
+		}
 
-					break
-				}
-			}
+		for i := 4; i+frame.textEncoding.Size <= frameSize; i += frame.textEncoding.Size {
+			if frameBody[i] == 0 {
+				frame.contentDescriptor = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
+				frame.lyricsOrText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
 
-			frames = append(frames, frame)
-		default:
-			frame := UnknownFrame{
-				frameBase: frameBase,
-				data:      frameBody,
+				break
 			}
+		}
 
-			frames = append(frames, frame)
+		return frame
+	case TypeComments:
+		if frameSize < 4 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
 		}
-	}
 
-	tag := new(Tag)
-	tag.frames = frames
-	tag.Size = framesSize
-	// Flags
-	tag.UnsynchronisationFlag = flag&128 == 128
-	tag.ExtendedHeaderFlag = flag&64 == 64
-	tag.ExperimentalIndicatorFlag = flag&32 == 32
-	tag.FooterPresentFlag = flag&16 == 16
+		frame := CommentsFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+			language:     string(frameBody[1:4]),
+		}
 
-	return tag, nil
+		for i := 4; i+frame.textEncoding.Size <= frameSize; i += frame.textEncoding.Size {
+			if frameBody[i] == 0 {
+				frame.shortContentDescription = lib.ToUTF8(frameBody[4:i], frame.textEncoding)
+				frame.theActualText = lib.ToUTF8(frameBody[i+frame.textEncoding.Size:], frame.textEncoding)
+
+				break
+			}
+		}
+
+		return frame
+	case TypeTermOfUse:
+		frame := TermOfUseFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+		}
+
+		if frameSize >= 4 {
+			text, _ := lib.SplitTerminated(frameBody[4:], frame.textEncoding)
+			frame.language = string(frameBody[1:4])
+			frame.theActualText = lib.ToUTF8(text, frame.textEncoding)
+		}
+
+		return frame
+	case TypePlayCounter:
+		frame := PlayCounterFrame{
+			frameBase: frameBase,
+			counter:   lib.ByteToInt(frameBody),
+		}
+
+		return frame
+	case TypePopularimeter:
+		email, rest := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := PopularimeterFrame{
+			frameBase:   frameBase,
+			emailToUser: string(email),
+		}
+
+		if len(rest) > 0 {
+			frame.rating = rest[0]
+			frame.counter = lib.ByteToInt(rest[1:])
+		}
+
+		return frame
+	case TypeGeneralEncapsulatedObject:
+		frame := GeneralEncapsulatedObjectFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+		}
+
+		mimeType, rest := lib.SplitTerminated(frameBody[1:], lib.Encodings[0])
+		filename, rest := lib.SplitTerminated(rest, frame.textEncoding)
+		description, rest := lib.SplitTerminated(rest, frame.textEncoding)
+
+		frame.mimeType = string(mimeType)
+		frame.filename = lib.ToUTF8(filename, frame.textEncoding)
+		frame.contentDescription = lib.ToUTF8(description, frame.textEncoding)
+		frame.encapsulatedObject = rest
+
+		return frame
+	case TypePrivate:
+		owner, data := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := PrivateFrame{
+			frameBase:       frameBase,
+			ownerIdentifier: string(owner),
+			privateData:     data,
+		}
+
+		return frame
+	case TypeUniqueFileIdentifier:
+		owner, identifier := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := UniqueFileIdentifierFrame{
+			frameBase:       frameBase,
+			ownerIdentifier: string(owner),
+			identifier:      identifier,
+		}
+
+		return frame
+	case TypeInvolvedPeopleList:
+		frame := InvolvedPeopleListFrame{
+			frameBase:  frameBase,
+			encoding:   lib.Encodings[frameBody[0]],
+			peopleList: lib.SplitStrings(frameBody[1:], lib.Encodings[frameBody[0]]),
+		}
+
+		return frame
+	case TypeMusicCDIdentifier:
+		frame := MusicCDIdentifierFrame{
+			frameBase: frameBase,
+			cdTOC:     frameBody,
+		}
+
+		return frame
+	case TypeEventTimingCodes:
+		frame := EventTimingCodesFrame{
+			frameBase:       frameBase,
+			timeStampFormat: TimeStampFormat(frameBody[0]),
+			events:          make([]Event, 0),
+		}
+
+		for i := 1; i+5 <= frameSize; i += 5 {
+			frame.events = append(frame.events, Event{
+				Type:      EventType(frameBody[i]),
+				TimeStamp: lib.ByteToInt(frameBody[i+1 : i+5]),
+			})
+		}
+
+		return frame
+	case TypeSyncedTempoCodes:
+		frame := SyncedTempoCodesFrame{
+			frameBase:       frameBase,
+			timeStampFormat: TimeStampFormat(frameBody[0]),
+			tempos:          make([]Tempo, 0),
+		}
+
+		for i := 1; i < frameSize; {
+			bpm := int(frameBody[i])
+			i++
+
+			if bpm == 0xff && i < frameSize {
+				bpm += int(frameBody[i])
+				i++
+			}
+
+			if i+4 > frameSize {
+				break
+			}
+
+			frame.tempos = append(frame.tempos, Tempo{
+				BPM:       bpm,
+				TimeStamp: lib.ByteToInt(frameBody[i : i+4]),
+			})
+			i += 4
+		}
+
+		return frame
+	case TypeMPEGLocationLookupTable:
+		if frameSize < 10 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := MPEGLocationLookupTableFrame{
+			frameBase:                    frameBase,
+			mpegFramesBetweenReference:   lib.ByteToInt(frameBody[0:2]),
+			bytesBetweenReference:        lib.ByteToInt(frameBody[2:5]),
+			millisecondsBetweenReference: lib.ByteToInt(frameBody[5:8]),
+			bitsForBytesDeviation:        int(frameBody[8]),
+			bitsForMillisecondsDeviation: int(frameBody[9]),
+			references:                   make([]MPEGLocationLookupTableReference, 0),
+		}
+
+		table := frameBody[10:]
+		referenceBits := frame.bitsForBytesDeviation + frame.bitsForMillisecondsDeviation
+
+		for i := 0; referenceBits > 0 && i+referenceBits <= len(table)*8; i += referenceBits {
+			frame.references = append(frame.references, MPEGLocationLookupTableReference{
+				BytesDeviation:        lib.Bits(table, i, frame.bitsForBytesDeviation),
+				MillisecondsDeviation: lib.Bits(table, i+frame.bitsForBytesDeviation, frame.bitsForMillisecondsDeviation),
+			})
+		}
+
+		return frame
+	case TypeAudioSeekPointIndex:
+		if frameSize < 11 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := AudioSeekPointIndexFrame{
+			frameBase:         frameBase,
+			indexedDataStart:  lib.ByteToInt(frameBody[0:4]),
+			indexedDataLength: lib.ByteToInt(frameBody[4:8]),
+			bitsPerIndexPoint: int(frameBody[10]),
+			indexPoints:       make([]int, 0),
+		}
+
+		pointSize := frame.bitsPerIndexPoint / 8
+		for i := 11; pointSize > 0 && i+pointSize <= frameSize; i += pointSize {
+			frame.indexPoints = append(frame.indexPoints, lib.ByteToInt(frameBody[i:i+pointSize]))
+		}
+
+		return frame
+	case TypeEqualisation:
+		identification, points := lib.SplitTerminated(frameBody[1:], lib.Encodings[0])
+		frame := EqualisationFrame{
+			frameBase:           frameBase,
+			interpolationMethod: InterpolationMethod(frameBody[0]),
+			identification:      string(identification),
+			points:              make([]EqualisationPoint, 0),
+		}
+
+		for i := 0; i+4 <= len(points); i += 4 {
+			frame.points = append(frame.points, EqualisationPoint{
+				Frequency:        lib.ByteToInt(points[i : i+2]),
+				VolumeAdjustment: int(int16(lib.ByteToInt(points[i+2 : i+4]))),
+			})
+		}
+
+		return frame
+	case TypeReverb:
+		if frameSize < 12 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := ReverbFrame{
+			frameBase:            frameBase,
+			reverbLeft:           lib.ByteToInt(frameBody[0:2]),
+			reverbRight:          lib.ByteToInt(frameBody[2:4]),
+			reverbBouncesLeft:    frameBody[4],
+			reverbBouncesRight:   frameBody[5],
+			feedbackLeftToLeft:   frameBody[6],
+			feedbackLeftToRight:  frameBody[7],
+			feedbackRightToRight: frameBody[8],
+			feedbackRightToLeft:  frameBody[9],
+			premixLeftToRight:    frameBody[10],
+			premixRightToLeft:    frameBody[11],
+		}
+
+		return frame
+	case TypeOwnership:
+		frame := OwnershipFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+		}
+
+		pricePaid, rest := lib.SplitTerminated(frameBody[1:], lib.Encodings[0])
+		frame.pricePaid = string(pricePaid)
+
+		if len(rest) >= 8 {
+			frame.dateOfPurchase = string(rest[:8])
+			seller, _ := lib.SplitTerminated(rest[8:], frame.textEncoding)
+			frame.seller = lib.ToUTF8(seller, frame.textEncoding)
+		}
+
+		return frame
+	case TypeCommercial:
+		frame := CommercialFrame{
+			frameBase:    frameBase,
+			textEncoding: lib.Encodings[frameBody[0]],
+		}
+
+		priceString, rest := lib.SplitTerminated(frameBody[1:], lib.Encodings[0])
+		frame.priceString = string(priceString)
+
+		if len(rest) >= 8 {
+			frame.validUntil = string(rest[:8])
+
+			var contactURL, nameOfSeller, description, pictureMIMEType []byte
+
+			contactURL, rest = lib.SplitTerminated(rest[8:], lib.Encodings[0])
+			frame.contactURL = string(contactURL)
+
+			if len(rest) > 0 {
+				frame.receivedAs = ReceivedAs(rest[0])
+				nameOfSeller, rest = lib.SplitTerminated(rest[1:], frame.textEncoding)
+				description, rest = lib.SplitTerminated(rest, frame.textEncoding)
+				pictureMIMEType, rest = lib.SplitTerminated(rest, lib.Encodings[0])
+
+				frame.nameOfSeller = lib.ToUTF8(nameOfSeller, frame.textEncoding)
+				frame.description = lib.ToUTF8(description, frame.textEncoding)
+				frame.pictureMIMEType = string(pictureMIMEType)
+				frame.sellerLogo = rest
+			}
+		}
+
+		return frame
+	case TypeAudioEncryption:
+		owner, rest := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := AudioEncryptionFrame{
+			frameBase:       frameBase,
+			ownerIdentifier: string(owner),
+		}
+
+		if len(rest) >= 4 {
+			frame.previewStart = lib.ByteToInt(rest[0:2])
+			frame.previewLength = lib.ByteToInt(rest[2:4])
+			frame.encryptionInfo = rest[4:]
+		}
+
+		return frame
+	case TypeEncryptionMethodRegistration:
+		owner, rest := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := EncryptionMethodRegistrationFrame{
+			frameBase:       frameBase,
+			ownerIdentifier: string(owner),
+		}
+
+		if len(rest) > 0 {
+			frame.methodSymbol = rest[0]
+			frame.encryptionData = rest[1:]
+		}
+
+		return frame
+	case TypeGroupIdentificationRegistration:
+		owner, rest := lib.SplitTerminated(frameBody, lib.Encodings[0])
+		frame := GroupIdentificationRegistrationFrame{
+			frameBase:       frameBase,
+			ownerIdentifier: string(owner),
+		}
+
+		if len(rest) > 0 {
+			frame.symbol = rest[0]
+			frame.groupDependentData = rest[1:]
+		}
+
+		return frame
+	case TypeLinkedInformation:
+		if frameSize < 5 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		url, idData := lib.SplitTerminated(frameBody[4:], lib.Encodings[0])
+
+		return LinkedInformationFrame{
+			frameBase:       frameBase,
+			frameIdentifier: string(frameBody[:4]),
+			url:             string(url),
+			idData:          idData,
+		}
+	case TypeSignature:
+		return SignatureFrame{
+			frameBase: frameBase,
+			symbol:    frameBody[0],
+			signature: frameBody[1:],
+		}
+	case TypeRecommendedBufferSize:
+		if frameSize < 4 {
+			return UnknownFrame{frameBase: frameBase, data: frameBody}
+		}
+
+		frame := RecommendedBufferSizeFrame{
+			frameBase:    frameBase,
+			bufferSize:   lib.ByteToInt(frameBody[0:3]),
+			embeddedInfo: frameBody[3]&1 == 1,
+		}
+
+		if frameSize >= 8 {
+			frame.offsetToNextTag = lib.ByteToInt(frameBody[4:8])
+		}
+
+		return frame
+	case TypePositionSynchronisation:
+		return PositionSynchronisationFrame{
+			frameBase:       frameBase,
+			timeStampFormat: TimeStampFormat(frameBody[0]),
+			position:        lib.ByteToInt(frameBody[1:]),
+		}
+	case TypeiTunesCompilationFlag:
+		frame := ItunesCompilationFlagFrame{
+			frameBase: frameBase,
+			encoding:  lib.Encodings[frameBody[0]],
+		}
+
+		frame.isPartOfACompilation = strings.TrimRight(lib.ToUTF8(frameBody[1:], frame.encoding), "\x00") == "1"
+
+		return frame
+	default:
+		frame := UnknownFrame{
+			frameBase: frameBase,
+			data:      frameBody,
+		}
+
+		return frame
+	}
 }
 
 func (tag Tag) Frames(ids ...string) []Frame {
@@ -664,6 +1988,109 @@ func (tag Tag) Frames(ids ...string) []Frame {
 	return frames
 }
 
+// AddFrame will add frame to the tag, the file is not changed.
+func (tag *Tag) AddFrame(frame Frame) {
+	tag.frames = append(tag.frames, frame)
+}
+
+// RemoveFrames will remove frames which match returns true for, the file is not changed.
+func (tag *Tag) RemoveFrames(match func(Frame) bool) {
+	frames := make([]Frame, 0, len(tag.frames))
+
+	for i := range tag.frames {
+		if !match(tag.frames[i]) {
+			frames = append(frames, tag.frames[i])
+		}
+	}
+
+	tag.frames = frames
+}
+
+// Texts will return values of the first text information frame with given id.
+func (tag Tag) Texts(id string) []string {
+	values := make([]string, 0)
+
+	for _, value := range strings.Split(tag.text(id), "\x00") {
+		if value != "" {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// joinedText will return text of text information frame with given id as one string, multiple values are joined by "/".
+func (tag Tag) joinedText(id string) string {
+	return strings.Join(tag.Texts(id), "/")
+}
+
+// SetText will replace text information frames with given id, without values frames are removed.
+// The new frame is decoded like a read frame, so text based frames like compilation flag have their own type.
+func (tag *Tag) SetText(id string, values ...string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		return frame.ID() == id
+	})
+
+	if len(values) > 0 {
+		frame := NewTextInformationFrame(id, values...)
+		tag.AddFrame(newFrame(frame.frameBase, frame.Body()))
+	}
+}
+
+// SetUserDefinedTextInformation will replace user defined text information with given description,
+// description is case-insensitive and without values frames are removed.
+func (tag *Tag) SetUserDefinedTextInformation(description string, values ...string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(UserDefinedTextInformationFrame)
+
+		return ok && strings.EqualFold(f.Description(), description)
+	})
+
+	if len(values) > 0 {
+		tag.AddFrame(NewUserDefinedTextInformationFrame(description, values...))
+	}
+}
+
+// SetUniqueFileIdentifier will replace unique file identifier of owner, without identifier frames are removed.
+func (tag *Tag) SetUniqueFileIdentifier(ownerIdentifier string, identifier []byte) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(UniqueFileIdentifierFrame)
+
+		return ok && f.OwnerIdentifier() == ownerIdentifier
+	})
+
+	if len(identifier) > 0 {
+		tag.AddFrame(NewUniqueFileIdentifierFrame(ownerIdentifier, identifier))
+	}
+}
+
+// text will return text of the first text information frame with given id.
+func (tag Tag) text(id string) string {
+	frames := tag.Frames(id)
+	if len(frames) > 0 {
+		frame, ok := frames[0].(TextInformationFrame)
+		if ok {
+			return frame.Text()
+		}
+	}
+
+	return ""
+}
+
+// timestamp will return the first timestamp of text information frame with given id.
+func (tag Tag) timestamp(id string) lib.Timestamp {
+	ts, _ := lib.ParseTimestamp(strings.SplitN(tag.text(id), "\x00", 2)[0])
+
+	return ts
+}
+
+// milliseconds will return duration of text information frame with given id, which is in milliseconds.
+func (tag Tag) milliseconds(id string) time.Duration {
+	ms, _ := strconv.Atoi(strings.TrimSpace(strings.TrimRight(tag.text(id), "\x00")))
+
+	return time.Duration(ms) * time.Millisecond
+}
+
 func (tag Tag) Title() string {
 	frames := tag.Frames("TIT2")
 	if len(frames) > 0 {
@@ -676,6 +2103,16 @@ func (tag Tag) Title() string {
 	return ""
 }
 
+// Artist will return lead artist(s) as stored, without splitting multiple artists.
+func (tag Tag) Artist() string {
+	return strings.TrimRight(tag.text("TPE1"), "\x00")
+}
+
+// AlbumArtist will return band or album artist(s) as stored, without splitting multiple artists.
+func (tag Tag) AlbumArtist() string {
+	return strings.TrimRight(tag.text("TPE2"), "\x00")
+}
+
 func (tag Tag) Artists() []string {
 	artists := make([]string, 0)
 	frames := tag.Frames("TPE1")
@@ -732,98 +2169,823 @@ func (tag Tag) Year() string {
 	return ""
 }
 
-func (tag Tag) TrackNumberAndPosition() (int, int) {
-	frames := tag.Frames("TRCK")
-	trk, pos := 0, 0
+// RecordingTime will return recording time (TDRC).
+func (tag Tag) RecordingTime() lib.Timestamp {
+	return tag.timestamp("TDRC")
+}
+
+// SetRecordingTime will replace recording time (TDRC), a zero timestamp removes it.
+func (tag *Tag) SetRecordingTime(ts lib.Timestamp) {
+	if ts.IsZero() {
+		tag.SetText("TDRC")
+
+		return
+	}
+
+	tag.SetText("TDRC", ts.String())
+}
+
+// ReleaseTime will return release time (TDRL).
+func (tag Tag) ReleaseTime() lib.Timestamp {
+	return tag.timestamp("TDRL")
+}
+
+// OriginalReleaseTime will return original release time (TDOR).
+func (tag Tag) OriginalReleaseTime() lib.Timestamp {
+	return tag.timestamp("TDOR")
+}
+
+// TaggingTime will return tagging time (TDTG).
+func (tag Tag) TaggingTime() lib.Timestamp {
+	return tag.timestamp("TDTG")
+}
+
+// TrackNumberAndPosition will return track number and total tracks (TRCK), ok is false without a track number.
+func (tag Tag) TrackNumberAndPosition() (int, int, bool) {
+	return lib.ParseNumberAndTotal(tag.text("TRCK"))
+}
 
+// IsCompilation will return iTunes compilation flag.
+func (tag Tag) IsCompilation() bool {
+	frames := tag.Frames("TCMP")
 	if len(frames) > 0 {
-		frame, ok := frames[0].(TextInformationFrame)
+		frame, ok := frames[0].(ItunesCompilationFlagFrame)
 		if ok {
-			t := strings.Split(frame.Text(), "/")
+			return frame.IsPartOfACompilation()
+		}
+	}
 
-			if len(t) > 0 {
-				trk, _ = strconv.Atoi(t[0])
-			}
+	return false
+}
 
-			if len(t) > 1 {
-				pos, _ = strconv.Atoi(t[1])
+// Grouping will return iTunes grouping, or content group description of tags written before iTunes 12.5.4.
+func (tag Tag) Grouping() string {
+	for _, id := range []string{"GRP1", "TIT1"} {
+		frames := tag.Frames(id)
+		if len(frames) > 0 {
+			frame, ok := frames[0].(TextInformationFrame)
+			if ok && frame.Text() != "" {
+				return frame.Text()
 			}
 		}
 	}
 
-	return trk, pos
+	return ""
 }
 
-func (tag Tag) AttachedPictures() []AttachedPictureFrame {
-	frames := tag.Frames("APIC")
-	pics := make([]AttachedPictureFrame, 0)
+// Movement will return movement name, movement number and movement count of classical works.
+func (tag Tag) Movement() (string, int, int) {
+	number, count, _ := lib.ParseNumberAndTotal(tag.text("MVIN"))
 
-	for i := range frames {
-		if pic, ok := frames[i].(AttachedPictureFrame); ok {
-			pics = append(pics, pic)
-		}
-	}
+	return tag.text("MVNM"), number, count
+}
 
-	return pics
+func (tag Tag) TitleSortOrder() string {
+	return tag.joinedText("TSOT")
 }
 
-func genreProcess(s string) string {
-	idxs := regexp.MustCompile("[(][0-9]+[)]").FindStringIndex(s)
-	if len(s[idxs[1]:]) > 0 && s[idxs[1]] != 0 {
-		return s[idxs[1]:]
-	}
+func (tag Tag) ArtistSortOrder() string {
+	return tag.joinedText("TSOP")
+}
 
-	id, err := strconv.Atoi(strings.Trim(s[idxs[0]:idxs[1]], "()"))
-	if err == nil {
-		if len(v1.Genres) > id {
-			return v1.Genres[id]
-		}
-	}
+func (tag Tag) AlbumSortOrder() string {
+	return tag.joinedText("TSOA")
+}
 
-	return ""
+func (tag Tag) AlbumArtistSortOrder() string {
+	return tag.joinedText("TSO2")
 }
 
-func (tag Tag) Genres() []string {
-	genres := make([]string, 0)
-	re := regexp.MustCompile("[(][0-9]+[)]")
+func (tag Tag) ComposerSortOrder() string {
+	return tag.joinedText("TSOC")
+}
 
-	frames := tag.Frames("TCON")
-	for i := range frames {
-		if tif, ok := frames[i].(TextInformationFrame); ok {
-			txt := tif.Text()
-			// Check normal number
-			id, err := strconv.Atoi(txt)
-			if err == nil {
-				if len(v1.Genres) > id {
-					genres = append(genres, v1.Genres[id])
-				}
+// DiscNumberAndTotal will return disc number and total discs of a set (TPOS), ok is false without a disc number.
+func (tag Tag) DiscNumberAndTotal() (int, int, bool) {
+	return lib.ParseNumberAndTotal(tag.text("TPOS"))
+}
 
-				continue
-			}
-			// check parentheses type
-			idxs := re.FindAllStringIndex(txt, -1)
-			if len(idxs) > 0 {
-				old := 0
-				for _, idx := range idxs {
-					if old == idx[0] {
-						continue
-					}
-					// txt[old:idx[0]]
-					if genre := genreProcess(txt[old:idx[0]]); genre != "" {
-						genres = append(genres, genre)
-					}
+func (tag Tag) SetSubtitle() string {
+	return tag.joinedText("TSST")
+}
 
-					old = idx[0]
-				}
-				// txt[old:]
-				if genre := genreProcess(txt[old:]); genre != "" {
-					genres = append(genres, genre)
-				}
-			} else {
-				genres = append(genres, txt)
-			}
-		}
-	}
+func (tag Tag) Subtitle() string {
+	return tag.joinedText("TIT3")
+}
 
-	return genres
+func (tag Tag) Composer() string {
+	return tag.joinedText("TCOM")
+}
+
+func (tag Tag) Lyricist() string {
+	return tag.joinedText("TEXT")
+}
+
+func (tag Tag) Conductor() string {
+	return tag.joinedText("TPE3")
+}
+
+// RemixedBy will return who interpreted, remixed, or otherwise modified the track (TPE4).
+func (tag Tag) RemixedBy() string {
+	return tag.joinedText("TPE4")
+}
+
+// InitialKey will return musical key in which the sound starts, like "Dbm" (TKEY).
+func (tag Tag) InitialKey() string {
+	return tag.joinedText("TKEY")
+}
+
+// ISRC will return international standard recording code (TSRC).
+func (tag Tag) ISRC() string {
+	return tag.joinedText("TSRC")
+}
+
+func (tag Tag) Publisher() string {
+	return tag.joinedText("TPUB")
+}
+
+// Copyright will return copyright message, like "2004 Label" (TCOP).
+func (tag Tag) Copyright() string {
+	return tag.joinedText("TCOP")
+}
+
+func (tag Tag) EncodedBy() string {
+	return tag.joinedText("TENC")
+}
+
+// EncoderSettings will return software, hardware and settings used for encoding (TSSE).
+func (tag Tag) EncoderSettings() string {
+	return tag.joinedText("TSSE")
+}
+
+// Language will return ISO 639-2 language code(s) of the audio (TLAN).
+func (tag Tag) Language() string {
+	return tag.joinedText("TLAN")
+}
+
+func (tag Tag) Mood() string {
+	return tag.joinedText("TMOO")
+}
+
+func (tag Tag) MediaType() string {
+	return tag.joinedText("TMED")
+}
+
+func (tag Tag) FileType() string {
+	return tag.joinedText("TFLT")
+}
+
+func (tag Tag) OriginalAlbum() string {
+	return tag.joinedText("TOAL")
+}
+
+func (tag Tag) OriginalArtist() string {
+	return tag.joinedText("TOPE")
+}
+
+func (tag Tag) OriginalLyricist() string {
+	return tag.joinedText("TOLY")
+}
+
+func (tag Tag) OriginalFilename() string {
+	return tag.joinedText("TOFN")
+}
+
+func (tag Tag) FileOwner() string {
+	return tag.joinedText("TOWN")
+}
+
+// RadioStationName will return internet radio station name (TRSN).
+func (tag Tag) RadioStationName() string {
+	return tag.joinedText("TRSN")
+}
+
+// RadioStationOwner will return internet radio station owner (TRSO).
+func (tag Tag) RadioStationOwner() string {
+	return tag.joinedText("TRSO")
+}
+
+func (tag Tag) ProducedNotice() string {
+	return tag.joinedText("TPRO")
+}
+
+// BPM will return beats per minute, rounded to an integer.
+func (tag Tag) BPM() int {
+	bpm, _ := strconv.ParseFloat(strings.TrimSpace(strings.TrimRight(tag.text("TBPM"), "\x00")), 64)
+
+	return int(math.Round(bpm))
+}
+
+// PlaylistDelay will return silence between the end of previous track and this track (TDLY).
+func (tag Tag) PlaylistDelay() time.Duration {
+	return tag.milliseconds("TDLY")
+}
+
+// Lyrics will return the first unsynchronised lyrics without content descriptor, or the first lyrics.
+func (tag Tag) Lyrics() string {
+	lyrics := ""
+	frames := tag.Frames("USLT")
+
+	for i := range frames {
+		frame, ok := frames[i].(UnsynchronisedLyricsOrTextTranscriptionFrame)
+		if !ok {
+			continue
+		}
+
+		if frame.ContentDescriptor() == "" {
+			return frame.LyricsOrText()
+		}
+
+		if lyrics == "" {
+			lyrics = frame.LyricsOrText()
+		}
+	}
+
+	return lyrics
+}
+
+// SetComment will replace comments without short content description, without text they are removed.
+func (tag *Tag) SetComment(text string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(CommentsFrame)
+
+		return ok && f.ShortContentDescription() == ""
+	})
+
+	if text != "" {
+		tag.AddFrame(NewCommentsFrame("XXX", "", text))
+	}
+}
+
+// SetLyrics will replace unsynchronised lyrics without content descriptor, without lyrics they are removed.
+func (tag *Tag) SetLyrics(lyrics string) {
+	tag.RemoveFrames(func(frame Frame) bool {
+		f, ok := frame.(UnsynchronisedLyricsOrTextTranscriptionFrame)
+
+		return ok && f.ContentDescriptor() == ""
+	})
+
+	if lyrics != "" {
+		tag.AddFrame(NewUnsynchronisedLyricsOrTextTranscriptionFrame("XXX", "", lyrics))
+	}
+}
+
+// Comment will return the first comment without short content description, or the first comment.
+func (tag Tag) Comment() string {
+	comment := ""
+	frames := tag.Frames("COMM")
+
+	for i := range frames {
+		frame, ok := frames[i].(CommentsFrame)
+		if !ok {
+			continue
+		}
+
+		if frame.ShortContentDescription() == "" {
+			return frame.TheActualText()
+		}
+
+		if comment == "" {
+			comment = frame.TheActualText()
+		}
+	}
+
+	return comment
+}
+
+func (tag Tag) AttachedPictures() []AttachedPictureFrame {
+	frames := tag.Frames("APIC")
+	pics := make([]AttachedPictureFrame, 0)
+
+	for i := range frames {
+		if pic, ok := frames[i].(AttachedPictureFrame); ok {
+			pics = append(pics, pic)
+		}
+	}
+
+	return pics
+}
+
+func (tag Tag) GeneralEncapsulatedObjects() []GeneralEncapsulatedObjectFrame {
+	frames := tag.Frames("GEOB")
+	objects := make([]GeneralEncapsulatedObjectFrame, 0)
+
+	for i := range frames {
+		if object, ok := frames[i].(GeneralEncapsulatedObjectFrame); ok {
+			objects = append(objects, object)
+		}
+	}
+
+	return objects
+}
+
+// PrivateFrames will return private frames, filtered by owner identifiers if any given.
+func (tag Tag) PrivateFrames(owners ...string) []PrivateFrame {
+	frames := tag.Frames("PRIV")
+	privs := make([]PrivateFrame, 0)
+
+	for i := range frames {
+		priv, ok := frames[i].(PrivateFrame)
+		if !ok {
+			continue
+		}
+
+		if len(owners) == 0 {
+			privs = append(privs, priv)
+
+			continue
+		}
+
+		for j := range owners {
+			if priv.OwnerIdentifier() == owners[j] {
+				privs = append(privs, priv)
+
+				break
+			}
+		}
+	}
+
+	return privs
+}
+
+func (tag Tag) PlayCounter() int {
+	frames := tag.Frames("PCNT")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(PlayCounterFrame)
+		if ok {
+			return frame.Counter()
+		}
+	}
+
+	return 0
+}
+
+func (tag Tag) Popularimeters() []PopularimeterFrame {
+	frames := tag.Frames("POPM")
+	popularimeters := make([]PopularimeterFrame, 0)
+
+	for i := range frames {
+		if popularimeter, ok := frames[i].(PopularimeterFrame); ok {
+			popularimeters = append(popularimeters, popularimeter)
+		}
+	}
+
+	return popularimeters
+}
+
+func (tag Tag) UniqueFileIdentifier(owner string) []byte {
+	frames := tag.Frames("UFID")
+	for i := range frames {
+		frame, ok := frames[i].(UniqueFileIdentifierFrame)
+		if ok && frame.OwnerIdentifier() == owner {
+			return frame.Identifier()
+		}
+	}
+
+	return nil
+}
+
+// UserDefinedTextInformation will return value of user defined text with given description, description is case-insensitive.
+func (tag Tag) UserDefinedTextInformation(description string) string {
+	frames := tag.Frames("TXXX")
+	for i := range frames {
+		frame, ok := frames[i].(UserDefinedTextInformationFrame)
+		if ok && strings.EqualFold(frame.Description(), description) {
+			return frame.Value()
+		}
+	}
+
+	return ""
+}
+
+func (tag Tag) InvolvedPeople() []InvolvedPerson {
+	people := make([]InvolvedPerson, 0)
+	frames := tag.Frames("TIPL")
+
+	for i := range frames {
+		if frame, ok := frames[i].(InvolvedPeopleListFrame); ok {
+			people = append(people, frame.People()...)
+		}
+	}
+
+	return people
+}
+
+func (tag Tag) MusicianCredits() []InvolvedPerson {
+	people := make([]InvolvedPerson, 0)
+	frames := tag.Frames("TMCL")
+
+	for i := range frames {
+		if frame, ok := frames[i].(InvolvedPeopleListFrame); ok {
+			people = append(people, frame.People()...)
+		}
+	}
+
+	return people
+}
+
+func (tag Tag) MusicCDIdentifier() []byte {
+	frames := tag.Frames("MCDI")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(MusicCDIdentifierFrame)
+		if ok {
+			return frame.CDTOC()
+		}
+	}
+
+	return nil
+}
+
+func (tag Tag) EventTimingCodes() []EventTimingCodesFrame {
+	frames := tag.Frames("ETCO")
+	res := make([]EventTimingCodesFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(EventTimingCodesFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) SyncedTempoCodes() []SyncedTempoCodesFrame {
+	frames := tag.Frames("SYTC")
+	res := make([]SyncedTempoCodesFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(SyncedTempoCodesFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+// fullSize will return size of the whole tag, including header and footer.
+func (tag Tag) fullSize() int {
+	if tag.FooterPresentFlag {
+		return HeaderSize + tag.Size + FooterSize
+	}
+
+	return HeaderSize + tag.Size
+}
+
+// SeekOffset will return file offset of d using MPEG location lookup table, tag is expected at the start of file.
+// Audio seek point index is used when there is no MPEG location lookup table and length of audio is known.
+func (tag Tag) SeekOffset(d time.Duration) (int, bool) {
+	frames := tag.Frames("MLLT")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(MPEGLocationLookupTableFrame)
+		if ok {
+			return tag.fullSize() + frame.Seek(d), true
+		}
+	}
+
+	frames = tag.Frames("ASPI")
+	if length := tag.Length(); len(frames) > 0 && length > 0 {
+		frame, ok := frames[0].(AudioSeekPointIndexFrame)
+		if ok {
+			return frame.Seek(d, length), true
+		}
+	}
+
+	return 0, false
+}
+
+// Length will return length of audio from TLEN frame.
+func (tag Tag) Length() time.Duration {
+	return tag.milliseconds("TLEN")
+}
+
+func (tag Tag) Equalisations() []EqualisationFrame {
+	frames := tag.Frames("EQU2")
+	res := make([]EqualisationFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(EqualisationFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) Reverbs() []ReverbFrame {
+	frames := tag.Frames("RVRB")
+	res := make([]ReverbFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(ReverbFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) RecommendedBufferSizes() []RecommendedBufferSizeFrame {
+	frames := tag.Frames("RBUF")
+	res := make([]RecommendedBufferSizeFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(RecommendedBufferSizeFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) PositionSynchronisation() (PositionSynchronisationFrame, bool) {
+	frames := tag.Frames("POSS")
+
+	for i := range frames {
+		if frame, ok := frames[i].(PositionSynchronisationFrame); ok {
+			return frame, true
+		}
+	}
+
+	return PositionSynchronisationFrame{}, false
+}
+
+func (tag Tag) TermsOfUse() string {
+	frames := tag.Frames("USER")
+	if len(frames) > 0 {
+		frame, ok := frames[0].(TermOfUseFrame)
+		if ok {
+			return frame.TheActualText()
+		}
+	}
+
+	return ""
+}
+
+func (tag Tag) Ownerships() []OwnershipFrame {
+	frames := tag.Frames("OWNE")
+	res := make([]OwnershipFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(OwnershipFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) Commercials() []CommercialFrame {
+	frames := tag.Frames("COMR")
+	res := make([]CommercialFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(CommercialFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+// URLs will return URLs of URL link frames with given id.
+func (tag Tag) URLs(id string) []string {
+	frames := tag.Frames(id)
+	urls := make([]string, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(URLLinkFrame); ok {
+			urls = append(urls, frame.URL())
+		}
+	}
+
+	return urls
+}
+
+func (tag Tag) AudioEncryptions() []AudioEncryptionFrame {
+	frames := tag.Frames("AENC")
+	res := make([]AudioEncryptionFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(AudioEncryptionFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) EncryptionMethodRegistrations() []EncryptionMethodRegistrationFrame {
+	frames := tag.Frames("ENCR")
+	res := make([]EncryptionMethodRegistrationFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(EncryptionMethodRegistrationFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) GroupIdentificationRegistrations() []GroupIdentificationRegistrationFrame {
+	frames := tag.Frames("GRID")
+	res := make([]GroupIdentificationRegistrationFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(GroupIdentificationRegistrationFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+// Group will return frames which belong to the group with given symbol.
+func (tag Tag) Group(symbol byte) []Frame {
+	frames := make([]Frame, 0)
+
+	for i := range tag.frames {
+		if s, ok := tag.frames[i].GroupSymbol(); ok && s == symbol {
+			frames = append(frames, tag.frames[i])
+		}
+	}
+
+	return frames
+}
+
+// Decrypt will decrypt encrypted frames using d and encryption method registrations of the tag.
+// Frames with unregistered encryption methods stay encrypted.
+func (tag *Tag) Decrypt(d lib.Decrypter) error {
+	registrations := tag.EncryptionMethodRegistrations()
+
+	for i := range tag.frames {
+		frame, ok := tag.frames[i].(EncryptedFrame)
+		if !ok {
+			continue
+		}
+
+		for _, registration := range registrations {
+			if registration.MethodSymbol() != frame.encryptionMethod {
+				continue
+			}
+
+			body, err := d.Decrypt(registration.OwnerIdentifier(), registration.EncryptionData(), frame.Data())
+			if err != nil {
+				return fmt.Errorf("error on decrypt frame '%s': %w", frame.ID(), err)
+			}
+
+			body, err = frame.inflate(body)
+			if err != nil {
+				return fmt.Errorf("error on decode frame '%s': %w", frame.ID(), err)
+			}
+
+			tag.frames[i] = newFrame(frame.frameBase, body)
+
+			break
+		}
+	}
+
+	return nil
+}
+
+func (tag Tag) LinkedInformations() []LinkedInformationFrame {
+	frames := tag.Frames("LINK")
+	res := make([]LinkedInformationFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(LinkedInformationFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+func (tag Tag) Signatures() []SignatureFrame {
+	frames := tag.Frames("SIGN")
+	res := make([]SignatureFrame, 0)
+
+	for i := range frames {
+		if frame, ok := frames[i].(SignatureFrame); ok {
+			res = append(res, frame)
+		}
+	}
+
+	return res
+}
+
+// ResolveLinks will replace linked information frames by the frames they refer to, opening linked files with r.
+// Links to frames which are not found in the linked file, or to files without a tag of this version,
+// stay in the tag.
+func (tag *Tag) ResolveLinks(r lib.LinkResolver) error {
+	frames := make([]Frame, 0, len(tag.frames))
+
+	for i := range tag.frames {
+		link, ok := tag.frames[i].(LinkedInformationFrame)
+		if !ok {
+			frames = append(frames, tag.frames[i])
+
+			continue
+		}
+
+		linked, err := resolveLink(r, link)
+		if err != nil {
+			return fmt.Errorf("error on resolve link '%s': %w", link.URL(), err)
+		}
+
+		if len(linked) == 0 {
+			frames = append(frames, link)
+
+			continue
+		}
+
+		frames = append(frames, linked...)
+	}
+
+	tag.frames = frames
+
+	return nil
+}
+
+func resolveLink(r lib.LinkResolver, link LinkedInformationFrame) ([]Frame, error) {
+	f, err := r.Open(link.URL())
+	if err != nil {
+		return nil, fmt.Errorf("error on open: %w", err)
+	}
+
+	defer func() { _ = f.Close() }()
+
+	tag, err := New(f)
+	if errors.Is(err, ErrTagNotFound) {
+		// Files without a tag of this version have no frames which can replace the link.
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error on new: %w", err)
+	}
+
+	idData := strings.TrimRight(string(link.IDData()), "\x00")
+	res := make([]Frame, 0)
+
+	for _, frame := range tag.Frames(link.FrameIdentifier()) {
+		if _, ok := frame.(LinkedInformationFrame); ok {
+			continue
+		}
+
+		if linkMatches(frame, idData) {
+			res = append(res, frame)
+		}
+	}
+
+	return res, nil
+}
+
+// linkMatches reports whether frame is identified by additional id data of a link.
+func linkMatches(frame Frame, idData string) bool {
+	if idData == "" {
+		return true
+	}
+
+	switch f := frame.(type) {
+	case interface{ Description() string }:
+		return f.Description() == idData
+	case interface{ Language() string }:
+		return strings.HasPrefix(idData, f.Language())
+	}
+
+	return true
+}
+
+// Genres will return genres of content type, numeric references and "RX" and "CR" are resolved to names.
+func (tag Tag) Genres() []string {
+	genres := make([]string, 0)
+
+	frames := tag.Frames("TCON")
+	for i := range frames {
+		if tif, ok := frames[i].(TextInformationFrame); ok {
+			genres = append(genres, v1.ParseContentType(tif.Text())...)
+		}
+	}
+
+	return genres
+}
+
+// SetGenres will replace content type with genres, Remix and Cover are written as "RX" and "CR" references.
+func (tag *Tag) SetGenres(genres ...string) {
+	values := make([]string, 0, len(genres))
+
+	for _, genre := range genres {
+		switch {
+		case genre == "":
+		case strings.EqualFold(genre, v1.GenreRemix):
+			values = append(values, "RX")
+		case strings.EqualFold(genre, v1.GenreCover):
+			values = append(values, "CR")
+		default:
+			values = append(values, genre)
+		}
+	}
+
+	tag.SetText("TCON", values...)
 }
@@ -0,0 +1,244 @@
+package v24
+
+import (
+	"bytes"
+	"compress/zlib"
+	"testing"
+	"time"
+
+	"github.com/xonyagar/id3/lib"
+)
+
+type testFrame struct {
+	id    string
+	body  []byte
+	flags [2]byte
+}
+
+func syncsafe(n int) []byte {
+	return []byte{byte(n >> 21 & 0x7f), byte(n >> 14 & 0x7f), byte(n >> 7 & 0x7f), byte(n & 0x7f)}
+}
+
+// newTestTag will return tag of frames, the tag is followed by padding and audio data.
+func newTestTag(t *testing.T, frames ...testFrame) *Tag {
+	t.Helper()
+
+	b := make([]byte, 0)
+
+	for _, f := range frames {
+		b = append(b, f.id...)
+		b = append(b, syncsafe(len(f.body))...)
+		b = append(b, f.flags[:]...)
+		b = append(b, f.body...)
+	}
+
+	b = append(b, make([]byte, 16)...)
+	data := append([]byte{'I', 'D', '3', 4, 0, 0}, syncsafe(len(b))...)
+	data = append(data, b...)
+	data = append(data, make([]byte, 128)...)
+
+	tag, err := New(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("error on new tag: %v", err)
+	}
+
+	return tag
+}
+
+func TestNewInvalidEncoding(t *testing.T) {
+	for _, id := range []string{"GEOB", "COMM", "USLT", "TIPL", "TMCL", "TCMP", "USER", "OWNE", "COMR"} {
+		for _, body := range [][]byte{{4}, {4, 'a', 0, 'b'}, {255, 'a', 0, 'b', 0, 'c'}} {
+			tag := newTestTag(t, testFrame{id: id, body: body})
+
+			frames := tag.Frames(id)
+			if len(frames) != 1 {
+				t.Fatalf("Frames(%q) = %d frames, want 1", id, len(frames))
+			}
+
+			if _, ok := frames[0].(UnknownFrame); !ok {
+				t.Errorf("frame %q with encoding %d is %T, want UnknownFrame", id, body[0], frames[0])
+			}
+		}
+	}
+}
+
+func TestMovement(t *testing.T) {
+	tests := []struct {
+		mvin   string
+		number int
+		count  int
+	}{
+		{"", 0, 0},
+		{"2", 2, 0},
+		{"2/4", 2, 4},
+		{" 2 / 4 ", 2, 4},
+		{"II/4", 0, 0},
+	}
+
+	for _, tt := range tests {
+		tag := newTestTag(t,
+			testFrame{id: "MVNM", body: append([]byte{0}, "Allegro"...)},
+			testFrame{id: "MVIN", body: append([]byte{0}, tt.mvin...)},
+		)
+
+		name, number, count := tag.Movement()
+		if name != "Allegro" || number != tt.number || count != tt.count {
+			t.Errorf("Movement() with MVIN %q = %q, %d, %d, want %q, %d, %d",
+				tt.mvin, name, number, count, "Allegro", tt.number, tt.count)
+		}
+	}
+}
+
+func TestSortOrders(t *testing.T) {
+	tag := newTestTag(t,
+		testFrame{id: "TSO2", body: append([]byte{0}, "Beatles, The\x00"...)},
+		testFrame{id: "TSOC", body: append([]byte{0}, "Lennon, John"...)},
+	)
+
+	if got, want := tag.AlbumArtistSortOrder(), "Beatles, The"; got != want {
+		t.Errorf("AlbumArtistSortOrder() = %q, want %q", got, want)
+	}
+
+	if got, want := tag.ComposerSortOrder(), "Lennon, John"; got != want {
+		t.Errorf("ComposerSortOrder() = %q, want %q", got, want)
+	}
+}
+
+func TestNewTruncatedCommentsAndLyrics(t *testing.T) {
+	for _, id := range []string{"COMM", "USLT"} {
+		tag := newTestTag(t, testFrame{id: id, body: []byte{0, 'e', 'n'}})
+		if _, ok := tag.Frames(id)[0].(UnknownFrame); !ok {
+			t.Errorf("frame %q of 3 bytes is %T, want UnknownFrame", id, tag.Frames(id)[0])
+		}
+
+		// UTF-16 description terminated with a single byte at the end of the body.
+		tag = newTestTag(t, testFrame{id: id, body: []byte{1, 'e', 'n', 'g', 0}})
+		if _, ok := tag.Frames(id)[0].(UnknownFrame); ok {
+			t.Errorf("frame %q with truncated terminator is UnknownFrame", id)
+		}
+	}
+}
+
+func TestAudioSeekPointIndexSeek(t *testing.T) {
+	uniform := NewAudioSeekPointIndexFrame(1000, 10000, 8, []int{0, 64, 128, 192})
+
+	tests := []struct {
+		frame    AudioSeekPointIndexFrame
+		d        time.Duration
+		duration time.Duration
+		want     int
+	}{
+		{uniform, 0, 4 * time.Second, 1000},
+		{uniform, -time.Second, 4 * time.Second, 1000},
+		{uniform, time.Second, 4 * time.Second, 3500},
+		{uniform, 1500 * time.Millisecond, 4 * time.Second, 4750},
+		{uniform, 3500 * time.Millisecond, 4 * time.Second, 9750},
+		{uniform, 4 * time.Second, 4 * time.Second, 11000},
+		{uniform, 5 * time.Second, 4 * time.Second, 11000},
+		{uniform, time.Second, 0, 1000},
+		{NewAudioSeekPointIndexFrame(0, 1000, 8, []int{0, 128}), 500 * time.Millisecond, 2 * time.Second, 250},
+		{NewAudioSeekPointIndexFrame(0, 1000, 16, []int{0, 16384}), 1500 * time.Millisecond, 2 * time.Second, 625},
+		{NewAudioSeekPointIndexFrame(100, 1000, 8, nil), time.Second, 2 * time.Second, 100},
+	}
+
+	for _, tt := range tests {
+		if got := tt.frame.Seek(tt.d, tt.duration); got != tt.want {
+			t.Errorf("Seek(%v, %v) of %v = %d, want %d", tt.d, tt.duration, tt.frame.IndexPoints(), got, tt.want)
+		}
+	}
+}
+
+func TestSeekOffset(t *testing.T) {
+	aspi := testFrame{id: "ASPI", body: NewAudioSeekPointIndexFrame(1000, 10000, 8, []int{0, 64, 128, 192}).Body()}
+	tlen := testFrame{id: "TLEN", body: append([]byte{3}, "4000"...)}
+	// 400 bytes and 26 milliseconds between references, without references.
+	mllt := testFrame{id: "MLLT", body: []byte{0, 1, 0, 0x01, 0x90, 0, 0, 26, 8, 8}}
+
+	tests := []struct {
+		name   string
+		frames []testFrame
+		offset int
+		ok     bool
+	}{
+		{"none", nil, 0, false},
+		{"aspi without length", []testFrame{aspi}, 0, false},
+		{"aspi", []testFrame{aspi, tlen}, 3500, true},
+		// Offset of MLLT is after the tag of 10 bytes header, frames and 16 bytes padding.
+		{"mllt", []testFrame{mllt}, 10 + 20 + 16 + 15384, true},
+		{"mllt before aspi", []testFrame{aspi, tlen, mllt}, 10 + 25 + 15 + 20 + 16 + 15384, true},
+	}
+
+	for _, tt := range tests {
+		offset, ok := newTestTag(t, tt.frames...).SeekOffset(time.Second)
+		if offset != tt.offset || ok != tt.ok {
+			t.Errorf("SeekOffset(%s) = %d, %v, want %d, %v", tt.name, offset, ok, tt.offset, tt.ok)
+		}
+	}
+}
+
+// unsynchronise will insert $00 after every $FF.
+func unsynchronise(data []byte) []byte {
+	res := make([]byte, 0, len(data))
+
+	for _, b := range data {
+		res = append(res, b)
+
+		if b == 0xff {
+			res = append(res, 0)
+		}
+	}
+
+	return res
+}
+
+func TestDecrypt(t *testing.T) {
+	plain := append([]byte{3}, "Title"...)
+
+	var compressed bytes.Buffer
+
+	w := zlib.NewWriter(&compressed)
+	_, _ = w.Write(plain)
+	_ = w.Close()
+
+	// Frames are compressed, encrypted and unsynchronised in that order, XOR with $FF is the encryption.
+	xor := func(data []byte) []byte {
+		res := make([]byte, len(data))
+		for i := range data {
+			res[i] = data[i] ^ 0xff
+		}
+
+		return res
+	}
+
+	encrypted := xor(compressed.Bytes())
+	if !bytes.Contains(encrypted, []byte{0xff}) {
+		t.Fatalf("encrypted body %x has no $FF to unsynchronise", encrypted)
+	}
+
+	body := append([]byte{0x80}, syncsafe(len(plain))...)
+	body = append(body, unsynchronise(encrypted)...)
+
+	tag := newTestTag(t,
+		testFrame{id: "ENCR", body: append([]byte("owner\x00\x80"), "key"...)},
+		testFrame{id: "TIT2", body: body, flags: [2]byte{0, 0x0f}},
+	)
+
+	err := tag.Decrypt(lib.DecrypterFunc(func(owner string, key []byte, data []byte) ([]byte, error) {
+		if owner != "owner" || string(key) != "key" {
+			t.Errorf("Decrypt got owner %q and key %q, want %q and %q", owner, key, "owner", "key")
+		}
+
+		if !bytes.Equal(data, encrypted) {
+			t.Errorf("Decrypt got data %x, want deunsynchronised %x", data, encrypted)
+		}
+
+		return xor(data), nil
+	}))
+	if err != nil {
+		t.Fatalf("Decrypt() error = %v", err)
+	}
+
+	if got := tag.text("TIT2"); got != "Title" {
+		t.Errorf("TIT2 after Decrypt() = %q, want %q", got, "Title")
+	}
+}